@@ -0,0 +1,12 @@
+# Configuration files marked with a custom `env` attribute in .gitattributes,
+# such as `config/*.tfvars env=prod`
+data "gitlocal_attributes" "example" {
+  paths = ["config/prod.tfvars", "config/staging.tfvars"]
+}
+
+locals {
+  environments = {
+    for file in data.gitlocal_attributes.example.files :
+    file.path => file.attributes["env"] if contains(keys(file.attributes), "env")
+  }
+}
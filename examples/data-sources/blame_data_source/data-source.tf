@@ -0,0 +1,8 @@
+# Tag resources with the main author of the file defining them
+data "gitlocal_blame" "example" {
+  path = "infrastructure/network.tf"
+}
+
+locals {
+  network_owner = data.gitlocal_blame.example.authors[0].email
+}
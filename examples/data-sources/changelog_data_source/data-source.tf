@@ -0,0 +1,14 @@
+# Release notes of the api service since its previous release
+data "gitlocal_latest_tag" "api" {
+  prefix = "api/v"
+}
+
+data "gitlocal_changelog" "example" {
+  from  = data.gitlocal_latest_tag.api.name
+  paths = ["services/api"]
+}
+
+resource "local_file" "release_notes" {
+  filename = "${path.module}/RELEASE_NOTES.md"
+  content  = data.gitlocal_changelog.example.markdown
+}
@@ -0,0 +1,10 @@
+# Team owning the module defining the resources, to tag them with
+data "gitlocal_codeowners" "example" {
+  paths = ["modules/network/"]
+}
+
+locals {
+  owner_tags = {
+    Owner = try(data.gitlocal_codeowners.example.owners[0].owners[0], "unowned")
+  }
+}
@@ -1,4 +1,37 @@
 # Get a specific commit
 data "gitlocal_commit" "example" {
   hash = "ABC123"
-}
\ No newline at end of file
+}
+
+# Refuse to apply unless HEAD was signed by a trusted key
+data "gitlocal_head" "current" {}
+
+data "gitlocal_commit" "signed" {
+  hash         = data.gitlocal_head.current.hash
+  keyring_file = "${path.module}/trusted-keys.asc"
+
+  lifecycle {
+    postcondition {
+      condition     = self.signature_verified
+      error_message = "HEAD must be signed by a trusted key."
+    }
+  }
+}
+
+# Check a commit signed with an SSH key, using the allowed signers file of git
+data "gitlocal_commit" "ssh_signed" {
+  hash                 = data.gitlocal_head.current.hash
+  allowed_signers_file = pathexpand("~/.config/git/allowed_signers")
+}
+
+# Refuse to apply unless HEAD references a change ticket
+data "gitlocal_commit" "change" {
+  hash = data.gitlocal_head.current.hash
+
+  lifecycle {
+    postcondition {
+      condition     = contains([for trailer in self.trailers : trailer.key], "Change-Ticket")
+      error_message = "HEAD must have a Change-Ticket trailer."
+    }
+  }
+}
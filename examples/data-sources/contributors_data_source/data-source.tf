@@ -0,0 +1,16 @@
+# Owners of the network configuration, and a warning when a single person
+# knows it
+data "gitlocal_contributors" "example" {
+  paths = ["infra/network"]
+}
+
+check "network_bus_factor" {
+  assert {
+    condition     = length(data.gitlocal_contributors.example.contributors) > 1
+    error_message = "Only one person has ever changed infra/network."
+  }
+}
+
+output "network_owner" {
+  value = data.gitlocal_contributors.example.contributors[0].email
+}
@@ -0,0 +1,8 @@
+# Tag the image of a service with the last commit that changed its directory
+data "gitlocal_file_history" "example" {
+  path = "services/api"
+}
+
+output "api_image_tag" {
+  value = substr(data.gitlocal_file_history.example.hash, 0, 12)
+}
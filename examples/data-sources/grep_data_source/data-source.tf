@@ -0,0 +1,10 @@
+# Find the stacks marked for deployment with a `# deploy: true` comment
+data "gitlocal_grep" "example" {
+  pattern     = "^#\\s*deploy:\\s*true\\s*$"
+  ignore_case = true
+  paths       = ["stacks/**/main.tf"]
+}
+
+output "deployed_stacks" {
+  value = toset([for match in data.gitlocal_grep.example.matches : dirname(match.path)])
+}
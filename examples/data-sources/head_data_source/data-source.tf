@@ -1,2 +1,7 @@
 # Get the head of the repository
-data "gitlocal_head" "example" {}
\ No newline at end of file
+data "gitlocal_head" "example" {}
+
+# Get the head of a sibling repository
+data "gitlocal_head" "app" {
+  repository_path = "../app"
+}
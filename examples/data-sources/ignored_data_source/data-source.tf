@@ -0,0 +1,11 @@
+# Generated files that must never be committed
+data "gitlocal_ignored" "example" {
+  paths = ["secrets.auto.tfvars", ".terraform/"]
+}
+
+check "generated_files_ignored" {
+  assert {
+    condition     = length(data.gitlocal_ignored.example.ignored_paths) == length(data.gitlocal_ignored.example.paths)
+    error_message = "Add the generated files to .gitignore."
+  }
+}
@@ -0,0 +1,9 @@
+# Latest release of a component of a monorepo, tagged as `api/v1.2.3`
+data "gitlocal_latest_tag" "example" {
+  prefix         = "api/v"
+  reachable_from = "HEAD"
+}
+
+output "api_version" {
+  value = data.gitlocal_latest_tag.example.version
+}
@@ -0,0 +1,8 @@
+# Version of the next release of a component of a monorepo
+data "gitlocal_next_version" "example" {
+  prefix = "api/v"
+}
+
+output "api_release" {
+  value = data.gitlocal_next_version.example.bump == "none" ? null : data.gitlocal_next_version.example.next_tag
+}
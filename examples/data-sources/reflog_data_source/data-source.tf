@@ -0,0 +1,9 @@
+# Get the last update of the main branch
+data "gitlocal_reflog" "example" {
+  reference   = "main"
+  max_entries = 1
+}
+
+output "last_moved_by" {
+  value = data.gitlocal_reflog.example.entries[0].committer_email
+}
@@ -0,0 +1,9 @@
+# List the deployment references, except the ones for staging
+data "gitlocal_refs" "example" {
+  include = ["refs/deploy/**"]
+  exclude = ["refs/deploy/staging/*"]
+}
+
+output "deployed" {
+  value = { for ref in data.gitlocal_refs.example.references : ref.name => ref.peeled }
+}
@@ -0,0 +1,6 @@
+# Get the layout of the repository
+data "gitlocal_repository" "example" {}
+
+output "worktree_root" {
+  value = data.gitlocal_repository.example.worktree_root
+}
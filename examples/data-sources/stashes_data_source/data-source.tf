@@ -0,0 +1,9 @@
+# List the stash entries of the repository
+data "gitlocal_stashes" "example" {}
+
+check "no_stashed_changes" {
+  assert {
+    condition     = length(data.gitlocal_stashes.example.stashes) == 0
+    error_message = "The repository has stashed changes that will not be applied."
+  }
+}
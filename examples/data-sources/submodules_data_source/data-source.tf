@@ -0,0 +1,6 @@
+# List the submodules of the repository
+data "gitlocal_submodules" "example" {}
+
+output "pinned_commits" {
+  value = { for submodule in data.gitlocal_submodules.example.submodules : submodule.path => submodule.commit }
+}
@@ -0,0 +1,9 @@
+# Get the commit of a release tag, checking it was signed by a trusted key
+data "gitlocal_tag" "example" {
+  name         = "v1.0.0"
+  keyring_file = "${path.module}/trusted-keys.asc"
+}
+
+output "release_commit" {
+  value = data.gitlocal_tag.example.signature_verified ? data.gitlocal_tag.example.commit : null
+}
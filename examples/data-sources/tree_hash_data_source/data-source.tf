@@ -0,0 +1,10 @@
+# Rebuild a service only when the content of its directory changes,
+# including changes that are not committed yet
+data "gitlocal_tree_hash" "example" {
+  path             = "services/api"
+  include_worktree = true
+}
+
+output "api_build_trigger" {
+  value = data.gitlocal_tree_hash.example.worktree_hash
+}
@@ -0,0 +1,6 @@
+# List the worktrees of the repository
+data "gitlocal_worktrees" "example" {}
+
+output "worktree_branches" {
+  value = { for worktree in data.gitlocal_worktrees.example.worktrees : worktree.path => worktree.branch }
+}
@@ -0,0 +1,12 @@
+# Refuses to write the secrets file unless git ignores it
+resource "local_sensitive_file" "secrets" {
+  filename = "${path.module}/secrets.auto.tfvars"
+  content  = "api_token = \"${var.api_token}\"\n"
+
+  lifecycle {
+    precondition {
+      condition     = provider::gitlocal::is_ignored("${path.module}/secrets.auto.tfvars")
+      error_message = "Add secrets.auto.tfvars to .gitignore before generating it."
+    }
+  }
+}
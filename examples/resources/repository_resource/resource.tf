@@ -0,0 +1,13 @@
+# Initialize an empty repository
+resource "gitlocal_repository" "scratch" {
+  path              = "/tmp/scratch"
+  initial_branch    = "main"
+  remove_on_destroy = true
+}
+
+# Clone a local repository
+resource "gitlocal_repository" "clone" {
+  path  = "/tmp/clone"
+  url   = "file:///path/to/repo"
+  depth = 1
+}
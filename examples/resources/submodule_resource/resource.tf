@@ -0,0 +1,8 @@
+# Pin the app submodule to a release commit
+resource "gitlocal_submodule" "app" {
+  path            = "apps/app"
+  url             = "https://github.com/example/app.git"
+  branch          = "main"
+  commit          = "0123456789abcdef0123456789abcdef01234567"
+  update_checkout = true
+}
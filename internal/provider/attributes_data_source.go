@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &attributesDataSource{}
+	_ datasource.DataSourceWithConfigure = &attributesDataSource{}
+)
+
+// NewAttributesDataSource is a helper function to simplify the provider implementation.
+func NewAttributesDataSource() datasource.DataSource {
+	return &attributesDataSource{}
+}
+
+// attributesDataSource is the data source implementation.
+type attributesDataSource struct {
+	data *gitlocalProviderData
+}
+
+// attributesDataSourceModel maps the data source schema data.
+type attributesDataSourceModel struct {
+	Files          []attributesFileModel `tfsdk:"files"`
+	Paths          []types.String        `tfsdk:"paths"`
+	RepositoryPath types.String          `tfsdk:"repository_path"`
+	Revision       types.String          `tfsdk:"revision"`
+}
+
+// attributesFileModel maps file attributes schema data.
+type attributesFileModel struct {
+	Attributes map[string]types.String `tfsdk:"attributes"`
+	Path       types.String            `tfsdk:"path"`
+}
+
+// Metadata returns the data source type name.
+func (d *attributesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attributes"
+}
+
+// Schema defines the schema for the data source.
+func (d *attributesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates the `.gitattributes` files committed at a revision for paths, as `git check-attr --all --source` does. " +
+			"Files in subdirectories take precedence over the files of their parents, and macros such as `binary` expand to the attributes they stand for.",
+		Attributes: map[string]schema.Attribute{
+			"files": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Attributes of each of `paths`, in the same order",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"attributes": schema.MapAttribute{
+							Computed:    true,
+							Description: "Attributes specified for the path by name, with the value `set`, `unset` or the value they are set to, such as `lfs` for `filter=lfs`",
+							ElementType: types.StringType,
+						},
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Path, as set in `paths`",
+						},
+					},
+				},
+			},
+			"paths": schema.ListAttribute{
+				Description: "Paths to evaluate the attributes of, relative to the root of the repository. The paths do not need to exist",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"repository_path": RepositoryPathAttribute(),
+			"revision":        RevisionAttribute(),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *attributesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state attributesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commit, diags := resolveCommit(repo, state.Revision)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reader, err := newAttributesReader(commit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read `"+gitattributesFileName+"`",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Files = []attributesFileModel{}
+
+	for _, p := range state.Paths {
+		attrs, err := reader.attributes(p.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Attributes of `"+p.ValueString()+"`",
+				err.Error(),
+			)
+			return
+		}
+
+		file := attributesFileModel{
+			Attributes: map[string]types.String{},
+			Path:       p,
+		}
+
+		for name, value := range attrs {
+			file.Attributes[name] = types.StringValue(value)
+		}
+
+		state.Files = append(state.Files, file)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *attributesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAttributesDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitFile(t, worktree, ".gitattributes", `[attr]env-file env-specific -diff
+*.txt              text
+notes.txt          -text
+*.png              binary
+*.bin              filter=lfs diff=lfs merge=lfs -text
+generated/**       linguist-generated
+/docs              export-ignore
+config/*.tfvars    env-file env=prod
+`)
+	testAccCommitFile(t, worktree, "config/.gitattributes", "staging.tfvars env=staging\n[attr]nested env-specific\n*.tfvars !diff\n")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_attributes" "test" {
+  paths = [
+    "notes.txt",
+    "logo.png",
+    "data.bin",
+    "generated/api/client.go",
+    "docs/index.md",
+    "config/prod.tfvars",
+    "config/staging.tfvars",
+  ]
+}
+
+data "gitlocal_attributes" "previous" {
+  revision = "HEAD~2"
+  paths    = ["notes.txt"]
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.#", "7"),
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.0.path", "notes.txt"),
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.0.attributes.text", "unset"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.1.attributes.%", "4"),
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.1.attributes.binary", "set"),
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.1.attributes.diff", "unset"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.2.attributes.filter", "lfs"),
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.2.attributes.text", "unset"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.3.attributes.linguist-generated", "set"),
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.4.attributes.%", "0"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.5.attributes.%", "3"),
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.5.attributes.env", "prod"),
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.5.attributes.env-specific", "set"),
+					resource.TestCheckNoResourceAttr("data.gitlocal_attributes.test", "files.5.attributes.diff"),
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.test", "files.6.attributes.env", "staging"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_attributes.previous", "files.0.attributes.%", "0"),
+				),
+			},
+		},
+	})
+}
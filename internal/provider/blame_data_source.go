@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &blameDataSource{}
+	_ datasource.DataSourceWithConfigure = &blameDataSource{}
+)
+
+// NewBlameDataSource is a helper function to simplify the provider implementation.
+func NewBlameDataSource() datasource.DataSource {
+	return &blameDataSource{}
+}
+
+// blameDataSource is the data source implementation.
+type blameDataSource struct {
+	data *gitlocalProviderData
+}
+
+// blameDataSourceModel maps the data source schema data.
+type blameDataSourceModel struct {
+	Authors          []blameAuthorModel `tfsdk:"authors"`
+	LastModifiedDate types.String       `tfsdk:"last_modified_date"`
+	LastModifiedHash types.String       `tfsdk:"last_modified_hash"`
+	Lines            []blameLineModel   `tfsdk:"lines"`
+	Path             types.String       `tfsdk:"path"`
+	RepositoryPath   types.String       `tfsdk:"repository_path"`
+	Revision         types.String       `tfsdk:"revision"`
+	UseMailmap       types.Bool         `tfsdk:"use_mailmap"`
+}
+
+// blameAuthorModel maps blame author schema data.
+type blameAuthorModel struct {
+	Email types.String `tfsdk:"email"`
+	Lines types.Int64  `tfsdk:"lines"`
+	Name  types.String `tfsdk:"name"`
+}
+
+// blameLineModel maps blame line schema data.
+type blameLineModel struct {
+	AuthorEmail types.String `tfsdk:"author_email"`
+	AuthorName  types.String `tfsdk:"author_name"`
+	Date        types.String `tfsdk:"date"`
+	Hash        types.String `tfsdk:"hash"`
+	Number      types.Int64  `tfsdk:"number"`
+	Text        types.String `tfsdk:"text"`
+}
+
+// Metadata returns the data source type name.
+func (d *blameDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blame"
+}
+
+// Schema defines the schema for the data source.
+func (d *blameDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attributes each line of a file at a revision to the commit that last changed it.",
+		Attributes: map[string]schema.Attribute{
+			"authors": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Distinct authors of the lines, ordered by decreasing number of lines then by email",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Computed:    true,
+							Description: "Email of the author",
+						},
+						"lines": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of lines last changed by the author",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the author, as recorded on their most recent line",
+						},
+					},
+				},
+			},
+			"last_modified_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Author date of `last_modified_hash` in RFC 3339",
+			},
+			"last_modified_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the most recent commit that changed the file, including commits that only removed lines",
+			},
+			"lines": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Lines of the file, in order",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"author_email": schema.StringAttribute{
+							Computed:    true,
+							Description: "Email of the author of the commit that last changed the line",
+						},
+						"author_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the author of the commit that last changed the line",
+						},
+						"date": schema.StringAttribute{
+							Computed:    true,
+							Description: "Author date of the commit that last changed the line in RFC 3339",
+						},
+						"hash": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash of the commit that last changed the line",
+						},
+						"number": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Line number, starting at 1",
+						},
+						"text": schema.StringAttribute{
+							Computed:    true,
+							Description: "Text of the line, without the line ending",
+						},
+					},
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the file, relative to the root of the repository",
+				Required:    true,
+			},
+			"repository_path": RepositoryPathAttribute(),
+			"revision":        RevisionAttribute(),
+			"use_mailmap":     MailmapAttribute(),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *blameDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state blameDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commit, diags := resolveCommit(repo, state.Revision)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filePath := state.Path.ValueString()
+
+	var blame *git.BlameResult
+
+	blamed, err := shallowCommit(repo, commit)
+	if err == nil {
+		blame, err = git.Blame(blamed, filePath)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Blame `"+filePath+"`",
+			err.Error(),
+		)
+		return
+	}
+
+	mailmap, err := readMailmap(repo, state.UseMailmap)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Mailmap",
+			err.Error(),
+		)
+		return
+	}
+
+	// The boundary commits of a shallow clone have no parents to compare
+	// with, so they count as changing every file they hold.
+	commits, err := logCommits(repo, commit, func(name string) bool {
+		return name == filePath
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read History of `"+filePath+"`",
+			err.Error(),
+		)
+		return
+	}
+	defer commits.Close()
+
+	lastModified, err := commits.Next()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read History of `"+filePath+"`",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Authors = nil
+	state.LastModifiedDate = types.StringValue(lastModified.Author.When.Format(time.RFC3339))
+	state.LastModifiedHash = types.StringValue(lastModified.Hash.String())
+	state.Lines = nil
+
+	authors := map[string]*blameAuthorModel{}
+	authorDates := map[string]time.Time{}
+
+	for i, line := range blame.Lines {
+		name, email := mailmap.resolve(line.AuthorName, line.Author)
+
+		state.Lines = append(state.Lines, blameLineModel{
+			AuthorEmail: types.StringValue(email),
+			AuthorName:  types.StringValue(name),
+			Date:        types.StringValue(line.Date.Format(time.RFC3339)),
+			Hash:        types.StringValue(line.Hash.String()),
+			Number:      types.Int64Value(int64(i + 1)),
+			Text:        types.StringValue(line.Text),
+		})
+
+		author, ok := authors[email]
+		if !ok {
+			author = &blameAuthorModel{
+				Email: types.StringValue(email),
+				Lines: types.Int64Value(0),
+			}
+			authors[email] = author
+		}
+
+		author.Lines = types.Int64Value(author.Lines.ValueInt64() + 1)
+
+		if !ok || line.Date.After(authorDates[email]) {
+			author.Name = types.StringValue(name)
+			authorDates[email] = line.Date
+		}
+	}
+
+	for _, author := range authors {
+		state.Authors = append(state.Authors, *author)
+	}
+
+	sort.Slice(state.Authors, func(i, j int) bool {
+		if state.Authors[i].Lines.ValueInt64() != state.Authors[j].Lines.ValueInt64() {
+			return state.Authors[i].Lines.ValueInt64() > state.Authors[j].Lines.ValueInt64()
+		}
+
+		return state.Authors[i].Email.ValueString() < state.Authors[j].Email.ValueString()
+	})
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// shallowCommit returns commit read through a storer that hides the missing
+// parents of the boundary commits of a shallow clone, so that walking its
+// history stops at them the way git does.
+func shallowCommit(repo *git.Repository, commit *object.Commit) (*object.Commit, error) {
+	shallow, err := repo.Storer.Shallow()
+	if err != nil || len(shallow) == 0 {
+		return commit, err
+	}
+
+	return object.GetCommit(shallowStorer{EncodedObjectStorer: repo.Storer, boundary: shallow}, commit.Hash)
+}
+
+// shallowStorer reads the boundary commits of a shallow clone without their
+// parents.
+type shallowStorer struct {
+	storer.EncodedObjectStorer
+
+	boundary []plumbing.Hash
+}
+
+// EncodedObject returns the object with the given hash, without its parents
+// when it is a boundary commit.
+func (s shallowStorer) EncodedObject(t plumbing.ObjectType, h plumbing.Hash) (plumbing.EncodedObject, error) {
+	obj, err := s.EncodedObjectStorer.EncodedObject(t, h)
+	if err != nil || obj.Type() != plumbing.CommitObject || !slices.Contains(s.boundary, h) {
+		return obj, err
+	}
+
+	commit, err := object.DecodeCommit(s, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	commit.ParentHashes = nil
+
+	encoded := &plumbing.MemoryObject{}
+	if err := commit.Encode(encoded); err != nil {
+		return nil, err
+	}
+
+	return boundaryObject{MemoryObject: encoded, hash: h}, nil
+}
+
+// boundaryObject is a boundary commit re-encoded without its parents, which
+// keeps the hash of the original commit.
+type boundaryObject struct {
+	*plumbing.MemoryObject
+
+	hash plumbing.Hash
+}
+
+// Hash returns the hash of the original commit.
+func (o boundaryObject) Hash() plumbing.Hash {
+	return o.hash
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *blameDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
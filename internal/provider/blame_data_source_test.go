@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestBlameDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "one\ntwo\n", "one\n2\nthree\n")
+	commits := testAccCommitHashes(t, fixture)
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove a line as another author, which leaves no line attributed to
+	// the commit.
+	if err := os.WriteFile(filepath.Join(fixture, "README.md"), []byte("one\n2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := worktree.Add("README.md"); err != nil {
+		t.Fatal(err)
+	}
+
+	other := object.Signature{Name: "Other Author", Email: "other@example.com", When: time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)}
+	removal, err := worktree.Commit("Remove line\n", &git.CommitOptions{Author: &other, Committer: &other})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_blame" "head" {
+  path = "README.md"
+}
+
+data "gitlocal_blame" "first" {
+  path     = "README.md"
+  revision = "HEAD~2"
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "lines.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "lines.0.number", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "lines.0.text", "one"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "lines.0.hash", commits[0]),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "lines.0.author_name", "Fixture Author"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "lines.0.author_email", "fixture@example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "lines.0.date", "2025-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "lines.1.text", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "lines.1.hash", commits[1]),
+
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "authors.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "authors.0.email", "fixture@example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "authors.0.lines", "2"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "last_modified_hash", removal.String()),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.head", "last_modified_date", "2025-02-03T04:05:06Z"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_blame.first", "lines.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.first", "lines.1.text", "two"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.first", "last_modified_hash", commits[0]),
+				),
+			},
+		},
+	})
+}
+
+func TestBlameDataSourceMailmap(t *testing.T) {
+	fixture := testAccMailmapFixture(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_blame" "mapped" {
+  path = "README.md"
+}
+
+data "gitlocal_blame" "unmapped" {
+  path        = "README.md"
+  use_mailmap = false
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_blame.mapped", "authors.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.mapped", "authors.0.email", "jane@example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.mapped", "authors.0.name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.mapped", "authors.0.lines", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.mapped", "lines.0.author_email", "jane@example.com"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_blame.unmapped", "authors.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.unmapped", "lines.0.author_email", "jane@old.example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.unmapped", "lines.1.author_name", "jane"),
+				),
+			},
+		},
+	})
+}
+
+func TestBlameDataSourceShallow(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "one\n", "one\ntwo\n")
+	boundary := testAccCommitHashes(t, fixture)[1]
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitFile(t, worktree, "CHANGELOG.md", "v1\n")
+	testAccShallowFixture(t, fixture, plumbing.NewHash(boundary))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_blame" "test" {
+  path = "README.md"
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_blame.test", "lines.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.test", "lines.0.hash", boundary),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.test", "lines.1.hash", boundary),
+					resource.TestCheckResourceAttr("data.gitlocal_blame.test", "last_modified_hash", boundary),
+				),
+			},
+		},
+	})
+}
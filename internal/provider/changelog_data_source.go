@@ -0,0 +1,497 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Defaults of the changelog, matching the sections of conventional-changelog.
+const (
+	changelogBreakingTitle   = "Breaking Changes"
+	changelogShortHashLength = 7
+	defaultChangelogRemote   = "origin"
+	defaultCommitURLTemplate = "{repository_url}/commit/{hash}"
+)
+
+// defaultChangelogGroups are the sections of the changelog when grouping by
+// Conventional Commit type.
+var defaultChangelogGroups = []changelogGroupModel{
+	{Key: types.StringValue("feat"), Title: types.StringValue("Features")},
+	{Key: types.StringValue("fix"), Title: types.StringValue("Bug Fixes")},
+	{Key: types.StringValue("perf"), Title: types.StringValue("Performance Improvements")},
+	{Key: types.StringValue("revert"), Title: types.StringValue("Reverts")},
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &changelogDataSource{}
+	_ datasource.DataSourceWithConfigure      = &changelogDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &changelogDataSource{}
+)
+
+// NewChangelogDataSource is a helper function to simplify the provider implementation.
+func NewChangelogDataSource() datasource.DataSource {
+	return &changelogDataSource{}
+}
+
+// changelogDataSource is the data source implementation.
+type changelogDataSource struct {
+	data *gitlocalProviderData
+}
+
+// changelogDataSourceModel maps the data source schema data.
+type changelogDataSourceModel struct {
+	CommitURLTemplate types.String          `tfsdk:"commit_url_template"`
+	Entries           []changelogEntryModel `tfsdk:"entries"`
+	From              types.String          `tfsdk:"from"`
+	GroupByTrailer    types.String          `tfsdk:"group_by_trailer"`
+	Groups            []changelogGroupModel `tfsdk:"groups"`
+	Markdown          types.String          `tfsdk:"markdown"`
+	Paths             []types.String        `tfsdk:"paths"`
+	Remote            types.String          `tfsdk:"remote"`
+	RepositoryPath    types.String          `tfsdk:"repository_path"`
+	RepositoryURL     types.String          `tfsdk:"repository_url"`
+	To                types.String          `tfsdk:"to"`
+}
+
+// changelogEntryModel maps changelog entry schema data.
+type changelogEntryModel struct {
+	Breaking    types.Bool   `tfsdk:"breaking"`
+	Description types.String `tfsdk:"description"`
+	Group       types.String `tfsdk:"group"`
+	Hash        types.String `tfsdk:"hash"`
+	Scope       types.String `tfsdk:"scope"`
+	Type        types.String `tfsdk:"type"`
+	URL         types.String `tfsdk:"url"`
+}
+
+// changelogGroupModel maps changelog group schema data.
+type changelogGroupModel struct {
+	Key   types.String `tfsdk:"key"`
+	Title types.String `tfsdk:"title"`
+}
+
+// Metadata returns the data source type name.
+func (d *changelogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_changelog"
+}
+
+// Schema defines the schema for the data source.
+func (d *changelogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a changelog of the commits between two revisions, grouped by [Conventional Commit](https://www.conventionalcommits.org) type or by the value of a trailer. " +
+			"Breaking changes are also listed in a first section.",
+		Attributes: map[string]schema.Attribute{
+			"commit_url_template": schema.StringAttribute{
+				Description: "Template of the link to each commit. `{repository_url}`, `{host}`, `{path}`, `{hash}` and `{short_hash}` are replaced by the web URL, host and path of the repository parsed from `remote`, and by the full and abbreviated hash of the commit. " +
+					"Defaults to `" + defaultCommitURLTemplate + "`, or to no link when the repository URL is unknown",
+				Optional: true,
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Commits of the changelog, most recent first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"breaking": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the commit is a breaking change, marked with `!` or a `BREAKING CHANGE` footer",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the commit, after the type and scope, or its subject when it is not a Conventional Commit",
+						},
+						"group": schema.StringAttribute{
+							Computed:    true,
+							Description: "Key of the group of the commit. Null for breaking changes outside of every group",
+						},
+						"hash": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash of the commit",
+						},
+						"scope": schema.StringAttribute{
+							Computed:    true,
+							Description: "Scope of the commit. Null when the commit has none",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the commit in lower case. Null when it is not a Conventional Commit",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "Link to the commit. Null when there is no link",
+						},
+					},
+				},
+			},
+			"from": schema.StringAttribute{
+				Description: "Revision the changelog starts after, such as the tag of the previous release. Defaults to the first commit",
+				Optional:    true,
+			},
+			"group_by_trailer": schema.StringAttribute{
+				Description: "Name of a trailer, such as `Changelog-Category`, to group commits by its value instead of by type",
+				Optional:    true,
+			},
+			"groups": schema.ListNestedAttribute{
+				Description: "Sections of the changelog, in order. Commits outside of every group are left out, unless they are breaking changes. " +
+					"Defaults to `feat`, `fix`, `perf` and `revert` when grouping by type, and to every trailer value titled as is when grouping by trailer",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "Commit type or trailer value of the commits of the group, compared case-insensitively",
+							Required:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "Title of the section",
+							Required:    true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"markdown": schema.StringAttribute{
+				Computed:    true,
+				Description: "Changelog in Markdown, with one level 3 heading per non-empty section",
+			},
+			"paths": schema.ListAttribute{
+				Description: "Only include commits changing these files or directories, relative to the root of the repository. " +
+					"Entries can be glob patterns, where `**` matches any number of directories. Defaults to every commit",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"remote": schema.StringAttribute{
+				Description: "Name of the remote whose URL links point to. Defaults to `" + defaultChangelogRemote + "`",
+				Optional:    true,
+			},
+			"repository_path": RepositoryPathAttribute(),
+			"repository_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Web URL of the repository parsed from the first URL of `remote`, such as `https://github.com/owner/repository`. Null when the remote does not exist or is local",
+			},
+			"to": schema.StringAttribute{
+				Description: "Revision the changelog ends at. Defaults to `HEAD`",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the path patterns.
+func (d *changelogDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config changelogDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, p := range config.Paths {
+		if p.IsNull() || p.IsUnknown() {
+			continue
+		}
+
+		if err := validateGlob(p.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("paths").AtListIndex(i),
+				"Invalid Path Pattern",
+				"The pattern `"+p.ValueString()+"` is not a valid glob: "+err.Error(),
+			)
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *changelogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state changelogDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	to, diags := resolveCommitAttribute(repo, path.Root("to"), state.To)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since := plumbing.ZeroHash
+	if !state.From.IsNull() {
+		from, diags := resolveCommitAttribute(repo, path.Root("from"), state.From)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		since = from.Hash
+	}
+
+	var pathFilter func(string) bool
+	if state.Paths != nil {
+		paths := stringValues(state.Paths, nil)
+		pathFilter = func(name string) bool {
+			return matchPathspecs(paths, name)
+		}
+	}
+
+	commits, err := commitsSince(repo, to, since, pathFilter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Commits",
+			err.Error(),
+		)
+		return
+	}
+
+	tokens, err := repositoryTrailerTokens(repo)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Trailer Configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	remoteName := defaultChangelogRemote
+	if !state.Remote.IsNull() {
+		remoteName = state.Remote.ValueString()
+	}
+
+	state.RepositoryURL = types.StringNull()
+
+	var endpoint *transport.Endpoint
+
+	remote, err := repo.Remote(remoteName)
+	switch {
+	case errors.Is(err, git.ErrRemoteNotFound):
+		// Links are only generated from the commit URL template.
+	case err != nil:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("remote"),
+			"Unable to Read Remote `"+remoteName+"`",
+			err.Error(),
+		)
+		return
+	case len(remote.Config().URLs) > 0:
+		if url, e, ok := repositoryWebURL(remote.Config().URLs[0]); ok {
+			state.RepositoryURL = types.StringValue(url)
+			endpoint = e
+		}
+	}
+
+	commitURLTemplate := state.CommitURLTemplate.ValueString()
+	if state.CommitURLTemplate.IsNull() && endpoint != nil {
+		commitURLTemplate = defaultCommitURLTemplate
+	}
+
+	groups := state.Groups
+	if groups == nil && state.GroupByTrailer.IsNull() {
+		groups = defaultChangelogGroups
+	}
+
+	state.Entries = nil
+
+	for _, commit := range commits {
+		entry, ok := newChangelogEntry(commit, state.GroupByTrailer, tokens, &groups, state.Groups == nil)
+		if !ok {
+			continue
+		}
+
+		if commitURLTemplate != "" {
+			entry.URL = types.StringValue(expandCommitURL(commitURLTemplate, state.RepositoryURL.ValueString(), endpoint, commit.Hash))
+		}
+
+		state.Entries = append(state.Entries, entry)
+	}
+
+	state.Markdown = types.StringValue(changelogMarkdown(state.Entries, groups))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *changelogDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// newChangelogEntry returns the entry of commit, and false when it belongs in
+// no group and is not a breaking change. When addGroups is true, a group
+// titled by the trailer value is added to groups for values not seen yet.
+func newChangelogEntry(commit *object.Commit, groupByTrailer types.String, trailerTokens []string, groups *[]changelogGroupModel, addGroups bool) (changelogEntryModel, bool) {
+	subject, _, _ := strings.Cut(commit.Message, "\n")
+
+	entry := changelogEntryModel{
+		Breaking:    types.BoolValue(false),
+		Description: types.StringValue(strings.TrimSpace(subject)),
+		Group:       types.StringNull(),
+		Hash:        types.StringValue(commit.Hash.String()),
+		Scope:       types.StringNull(),
+		Type:        types.StringNull(),
+		URL:         types.StringNull(),
+	}
+
+	key := ""
+
+	conventional, ok := parseConventionalCommit(commit.Message)
+	if ok {
+		entry.Breaking = types.BoolValue(conventional.breaking)
+		entry.Description = types.StringValue(conventional.description)
+		entry.Type = types.StringValue(conventional.typ)
+		key = conventional.typ
+
+		if conventional.scope != "" {
+			entry.Scope = types.StringValue(conventional.scope)
+		}
+	}
+
+	if !groupByTrailer.IsNull() {
+		key, ok = trailerValue(parseTrailers(commit.Message, trailerTokens), groupByTrailer.ValueString())
+
+		if ok && addGroups && changelogGroupIndex(*groups, key) < 0 {
+			*groups = append(*groups, changelogGroupModel{
+				Key:   types.StringValue(key),
+				Title: types.StringValue(key),
+			})
+		}
+	}
+
+	if i := changelogGroupIndex(*groups, key); ok && i >= 0 {
+		entry.Group = (*groups)[i].Key
+	}
+
+	return entry, !entry.Group.IsNull() || entry.Breaking.ValueBool()
+}
+
+// changelogGroupIndex returns the index of the group of key, or -1.
+func changelogGroupIndex(groups []changelogGroupModel, key string) int {
+	for i, group := range groups {
+		if strings.EqualFold(group.Key.ValueString(), key) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// changelogMarkdown renders entries as a Markdown list per section, starting
+// with the breaking changes.
+func changelogMarkdown(entries []changelogEntryModel, groups []changelogGroupModel) string {
+	var sections []string
+
+	section := func(title string, include func(changelogEntryModel) bool) {
+		var b strings.Builder
+
+		for _, entry := range entries {
+			if !include(entry) {
+				continue
+			}
+
+			b.WriteString("* ")
+			if !entry.Scope.IsNull() {
+				b.WriteString("**" + entry.Scope.ValueString() + ":** ")
+			}
+			b.WriteString(entry.Description.ValueString())
+
+			shortHash := entry.Hash.ValueString()[:changelogShortHashLength]
+			if entry.URL.IsNull() {
+				b.WriteString(" (" + shortHash + ")\n")
+			} else {
+				b.WriteString(" ([" + shortHash + "](" + entry.URL.ValueString() + "))\n")
+			}
+		}
+
+		if b.Len() > 0 {
+			sections = append(sections, "### "+title+"\n\n"+b.String())
+		}
+	}
+
+	section(changelogBreakingTitle, func(entry changelogEntryModel) bool {
+		return entry.Breaking.ValueBool()
+	})
+
+	for _, group := range groups {
+		section(group.Title.ValueString(), func(entry changelogEntryModel) bool {
+			return entry.Group.Equal(group.Key)
+		})
+	}
+
+	return strings.Join(sections, "\n")
+}
+
+// repositoryWebURL returns the web URL of the repository at the remote URL
+// rawURL, such as `https://github.com/owner/repository` for
+// `git@github.com:owner/repository.git`, and false for local repositories.
+func repositoryWebURL(rawURL string) (string, *transport.Endpoint, bool) {
+	endpoint, err := transport.NewEndpoint(rawURL)
+	if err != nil || endpoint.Protocol == "file" || endpoint.Host == "" {
+		return "", nil, false
+	}
+
+	endpoint.Path = strings.TrimSuffix(strings.Trim(endpoint.Path, "/"), ".git")
+
+	host := endpoint.Host
+	if (endpoint.Protocol == "http" || endpoint.Protocol == "https") && endpoint.Port != 0 {
+		host += ":" + strconv.Itoa(endpoint.Port)
+	}
+
+	scheme := "https"
+	if endpoint.Protocol == "http" {
+		scheme = "http"
+	}
+
+	return scheme + "://" + host + "/" + endpoint.Path, endpoint, true
+}
+
+// expandCommitURL replaces the placeholders of template for the commit hash.
+func expandCommitURL(template string, repositoryURL string, endpoint *transport.Endpoint, hash plumbing.Hash) string {
+	host, repositoryPath := "", ""
+	if endpoint != nil {
+		host, repositoryPath = endpoint.Host, endpoint.Path
+	}
+
+	return strings.NewReplacer(
+		"{repository_url}", repositoryURL,
+		"{host}", host,
+		"{path}", repositoryPath,
+		"{hash}", hash.String(),
+		"{short_hash}", hash.String()[:changelogShortHashLength],
+	).Replace(template)
+}
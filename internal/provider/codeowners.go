@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// codeownersLocations are the paths GitHub reads CODEOWNERS from, in order of
+// precedence.
+var codeownersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// codeownersRule is a line of a CODEOWNERS file.
+type codeownersRule struct {
+	line    int
+	owners  []string
+	pattern string
+}
+
+// parseCodeowners returns the rules of the CODEOWNERS file read from r, in
+// order. Blank lines and comments are skipped.
+func parseCodeowners(r io.Reader) ([]codeownersRule, error) {
+	var rules []codeownersRule
+
+	scanner := bufio.NewScanner(r)
+
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		rule := codeownersRule{
+			line:    line,
+			owners:  []string{},
+			pattern: strings.TrimPrefix(fields[0], `\`),
+		}
+
+		for _, owner := range fields[1:] {
+			if strings.HasPrefix(owner, "#") {
+				break
+			}
+
+			rule.owners = append(rule.owners, owner)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, scanner.Err()
+}
+
+// matchCodeownersPattern reports whether the CODEOWNERS pattern matches name, a
+// file path relative to the root of the repository, or a directory when it
+// ends with a slash. Patterns follow the gitignore rules, except that `dir/*`
+// does not match the files nested in subdirectories of dir.
+func matchCodeownersPattern(pattern string, name string) bool {
+	isDir := strings.HasSuffix(name, "/")
+	nameSegments := strings.Split(strings.Trim(name, "/"), "/")
+
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+
+	var segments []string
+	if strings.Contains(pattern, "/") {
+		segments = strings.Split(strings.TrimPrefix(pattern, "/"), "/")
+	} else {
+		segments = []string{"**", pattern}
+	}
+
+	// A pattern matching a directory matches everything under it, except for
+	// a trailing `*` which only matches the direct children.
+	matchParents := segments[len(segments)-1] != "*"
+
+	for i := 1; i <= len(nameSegments); i++ {
+		full := i == len(nameSegments)
+
+		if !full && !matchParents {
+			continue
+		}
+
+		if full && dirOnly && !isDir {
+			continue
+		}
+
+		if matchGlobSegments(segments, nameSegments[:i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolveCodeowners returns the last rule matching name, and false when no
+// rule matches it.
+func resolveCodeowners(rules []codeownersRule, name string) (codeownersRule, bool) {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if matchCodeownersPattern(rules[i].pattern, name) {
+			return rules[i], true
+		}
+	}
+
+	return codeownersRule{}, false
+}
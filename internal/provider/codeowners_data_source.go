@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &codeownersDataSource{}
+	_ datasource.DataSourceWithConfigure = &codeownersDataSource{}
+)
+
+// NewCodeownersDataSource is a helper function to simplify the provider implementation.
+func NewCodeownersDataSource() datasource.DataSource {
+	return &codeownersDataSource{}
+}
+
+// codeownersDataSource is the data source implementation.
+type codeownersDataSource struct {
+	data *gitlocalProviderData
+}
+
+// codeownersDataSourceModel maps the data source schema data.
+type codeownersDataSourceModel struct {
+	File           types.String           `tfsdk:"file"`
+	Owners         []codeownersOwnerModel `tfsdk:"owners"`
+	Paths          []types.String         `tfsdk:"paths"`
+	RepositoryPath types.String           `tfsdk:"repository_path"`
+	Revision       types.String           `tfsdk:"revision"`
+	Rules          []codeownersRuleModel  `tfsdk:"rules"`
+}
+
+// codeownersOwnerModel maps resolved owners schema data.
+type codeownersOwnerModel struct {
+	Line    types.Int64    `tfsdk:"line"`
+	Owners  []types.String `tfsdk:"owners"`
+	Path    types.String   `tfsdk:"path"`
+	Pattern types.String   `tfsdk:"pattern"`
+}
+
+// codeownersRuleModel maps rule schema data.
+type codeownersRuleModel struct {
+	Line    types.Int64    `tfsdk:"line"`
+	Owners  []types.String `tfsdk:"owners"`
+	Pattern types.String   `tfsdk:"pattern"`
+}
+
+// Metadata returns the data source type name.
+func (d *codeownersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_codeowners"
+}
+
+// Schema defines the schema for the data source.
+func (d *codeownersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the CODEOWNERS file of a revision and resolves the owners of paths. " +
+			"The file is looked up in `.github/`, the root of the repository and `docs/`, in that order, as GitHub does, and the last rule matching a path wins.",
+		Attributes: map[string]schema.Attribute{
+			"file": schema.StringAttribute{
+				Computed:    true,
+				Description: "Path of the CODEOWNERS file, relative to the root of the repository",
+			},
+			"owners": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Owners of each of `paths`, in the same order",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"line": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Line of the rule matching the path. Null when no rule matches",
+						},
+						"owners": schema.ListAttribute{
+							Computed:    true,
+							Description: "Owners of the path. Empty when no rule matches or the matching rule has no owners",
+							ElementType: types.StringType,
+						},
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Path, as set in `paths`",
+						},
+						"pattern": schema.StringAttribute{
+							Computed:    true,
+							Description: "Pattern of the rule matching the path. Null when no rule matches",
+						},
+					},
+				},
+			},
+			"paths": schema.ListAttribute{
+				Description: "Paths to resolve the owners of, relative to the root of the repository. Paths ending with `/` are matched as directories",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"repository_path": RepositoryPathAttribute(),
+			"revision":        RevisionAttribute(),
+			"rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Rules of the file, in order",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"line": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Line of the rule in the file, starting at 1",
+						},
+						"owners": schema.ListAttribute{
+							Computed:    true,
+							Description: "Users, teams and emails owning the files matching the pattern, in order",
+							ElementType: types.StringType,
+						},
+						"pattern": schema.StringAttribute{
+							Computed:    true,
+							Description: "Pattern of the files the rule applies to",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *codeownersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state codeownersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commit, diags := resolveCommit(repo, state.Revision)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var file *object.File
+
+	for _, location := range codeownersLocations {
+		f, err := commit.File(location)
+		if errors.Is(err, object.ErrFileNotFound) {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read `"+location+"`",
+				err.Error(),
+			)
+			return
+		}
+
+		file = f
+		break
+	}
+
+	if file == nil {
+		resp.Diagnostics.AddError(
+			"No CODEOWNERS Found",
+			"None of "+strings.Join(codeownersLocations, ", ")+" exists at `"+commit.Hash.String()+"`.",
+		)
+		return
+	}
+
+	reader, err := file.Reader()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read `"+file.Name+"`",
+			err.Error(),
+		)
+		return
+	}
+	defer reader.Close()
+
+	rules, err := parseCodeowners(reader)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read `"+file.Name+"`",
+			err.Error(),
+		)
+		return
+	}
+
+	state.File = types.StringValue(file.Name)
+	state.Owners = []codeownersOwnerModel{}
+	state.Rules = []codeownersRuleModel{}
+
+	for _, rule := range rules {
+		state.Rules = append(state.Rules, codeownersRuleModel{
+			Line:    types.Int64Value(int64(rule.line)),
+			Owners:  codeownersOwnerValues(rule.owners),
+			Pattern: types.StringValue(rule.pattern),
+		})
+	}
+
+	for _, p := range state.Paths {
+		owner := codeownersOwnerModel{
+			Line:    types.Int64Null(),
+			Owners:  []types.String{},
+			Path:    p,
+			Pattern: types.StringNull(),
+		}
+
+		if rule, ok := resolveCodeowners(rules, strings.TrimPrefix(p.ValueString(), "/")); ok {
+			owner.Line = types.Int64Value(int64(rule.line))
+			owner.Owners = codeownersOwnerValues(rule.owners)
+			owner.Pattern = types.StringValue(rule.pattern)
+		}
+
+		state.Owners = append(state.Owners, owner)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *codeownersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// codeownersOwnerValues converts the owners of a rule to their schema values.
+func codeownersOwnerValues(owners []string) []types.String {
+	values := []types.String{}
+	for _, owner := range owners {
+		values = append(values, types.StringValue(owner))
+	}
+
+	return values
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCodeownersDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	empty := testAccCommitHashes(t, fixture)[0]
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitFile(t, worktree, "docs/CODEOWNERS", "* @example/docs\n")
+	testAccCommitFile(t, worktree, ".github/CODEOWNERS", `# Default owners
+*                   @example/platform
+
+/modules/network/   @example/network @jane # Shared with the SRE team
+modules/*/outputs.tf
+*.md                docs@example.com
+`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_codeowners" "test" {
+  paths = [
+    "modules/network/main.tf",
+    "modules/network/outputs.tf",
+    "modules/network/README.md",
+    "modules/compute/",
+  ]
+}
+
+data "gitlocal_codeowners" "previous" {
+  revision = "HEAD~1"
+  paths    = ["README.md"]
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "file", ".github/CODEOWNERS"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "rules.#", "4"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "rules.1.line", "4"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "rules.1.pattern", "/modules/network/"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "rules.1.owners.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "rules.1.owners.1", "@jane"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "rules.2.owners.#", "0"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "owners.#", "4"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "owners.0.path", "modules/network/main.tf"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "owners.0.pattern", "/modules/network/"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "owners.0.owners.0", "@example/network"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "owners.1.line", "5"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "owners.1.owners.#", "0"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "owners.2.owners.0", "docs@example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.test", "owners.3.owners.0", "@example/platform"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.previous", "file", "docs/CODEOWNERS"),
+					resource.TestCheckResourceAttr("data.gitlocal_codeowners.previous", "owners.0.owners.0", "@example/docs"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_codeowners" "test" {
+  revision = %q
+}
+`, fixture, empty),
+				ExpectError: regexp.MustCompile(`No CODEOWNERS Found`),
+			},
+		},
+	})
+}
+
+func TestParseCodeowners(t *testing.T) {
+	rules, err := parseCodeowners(strings.NewReader("# Comment\n\n*.js @js-owner # Inline\n\\#notes.txt @notes\ndocs/\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []codeownersRule{
+		{line: 3, owners: []string{"@js-owner"}, pattern: "*.js"},
+		{line: 4, owners: []string{"@notes"}, pattern: "#notes.txt"},
+		{line: 5, owners: []string{}, pattern: "docs/"},
+	}
+
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("parseCodeowners() = %+v, want %+v", rules, want)
+	}
+}
+
+func TestMatchCodeownersPattern(t *testing.T) {
+	cases := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"*", "README.md", true},
+		{"*", "apps/api/main.go", true},
+		{"*.js", "app.js", true},
+		{"*.js", "src/lib/app.js", true},
+		{"*.js", "app.jsx", false},
+		{"/build/logs/", "build/logs/today.log", true},
+		{"/build/logs/", "build/logs", false},
+		{"/build/logs/", "src/build/logs/today.log", false},
+		{"docs/*", "docs/getting-started.md", true},
+		{"docs/*", "docs/build-app/troubleshooting.md", false},
+		{"apps/", "apps/api/main.go", true},
+		{"apps/", "src/apps/api/main.go", true},
+		{"apps/", "apps", false},
+		{"apps/", "apps/", true},
+		{"/docs", "docs/index.md", true},
+		{"/docs", "src/docs/index.md", false},
+		{"**/logs", "deeply/nested/logs/today.log", true},
+		{"modules/*/outputs.tf", "modules/network/outputs.tf", true},
+		{"modules/*/outputs.tf", "src/modules/network/outputs.tf", false},
+	}
+
+	for _, c := range cases {
+		if got := matchCodeownersPattern(c.pattern, c.name); got != c.want {
+			t.Errorf("matchCodeownersPattern(%q, %q) = %t, want %t", c.pattern, c.name, got, c.want)
+		}
+	}
+}
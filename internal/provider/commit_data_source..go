@@ -6,9 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
 	"time"
 
-	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -17,8 +17,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &commitDataSource{}
-	_ datasource.DataSourceWithConfigure = &commitDataSource{}
+	_ datasource.DataSource                   = &commitDataSource{}
+	_ datasource.DataSourceWithConfigure      = &commitDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &commitDataSource{}
 )
 
 // NewCommitDataSource is a helper function to simplify the provider implementation.
@@ -28,14 +29,23 @@ func NewCommitDataSource() datasource.DataSource {
 
 // commitDataSource is the data source implementation.
 type commitDataSource struct {
-	repo *git.Repository
+	data *gitlocalProviderData
 }
 
 // commitDataSourceModel maps the data source schema data.
 type commitDataSourceModel struct {
-	Date    types.String `tfsdk:"date"`
-	Hash    types.String `tfsdk:"hash"`
-	Message types.String `tfsdk:"message"`
+	AuthorEmail    types.String `tfsdk:"author_email"`
+	AuthorName     types.String `tfsdk:"author_name"`
+	CommitterEmail types.String `tfsdk:"committer_email"`
+	CommitterName  types.String `tfsdk:"committer_name"`
+	Date           types.String `tfsdk:"date"`
+	Hash           types.String `tfsdk:"hash"`
+	Message        types.String `tfsdk:"message"`
+	RepositoryPath types.String `tfsdk:"repository_path"`
+	UseMailmap     types.Bool   `tfsdk:"use_mailmap"`
+
+	signatureModel
+	trailersModel
 }
 
 // Metadata returns the data source type name.
@@ -45,34 +55,72 @@ func (d *commitDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 
 // Schema defines the schema for the data source.
 func (d *commitDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := SignatureAttributes()
+	maps.Copy(attributes, TrailerAttributes())
+	attributes["author_email"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Email of the author of the commit",
+	}
+	attributes["author_name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the author of the commit",
+	}
+	attributes["committer_email"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Email of the committer of the commit",
+	}
+	attributes["committer_name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the committer of the commit",
+	}
+	attributes["hash"] = schema.StringAttribute{
+		Description: "Hash of the commit",
+		Required:    true,
+	}
+	attributes["date"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Date of the commit in RFC 3339",
+	}
+	attributes["message"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Message of the commit",
+	}
+	attributes["repository_path"] = RepositoryPathAttribute()
+	attributes["use_mailmap"] = MailmapAttribute()
+
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"hash": schema.StringAttribute{
-				Description: "Hash of the commit",
-				Required:    true,
-			},
-			"date": schema.StringAttribute{
-				Computed:    true,
-				Description: "Date of the commit in RFC 3339",
-			},
-			"message": schema.StringAttribute{
-				Computed:    true,
-				Description: "Message of the commit",
-			},
-		},
+		Attributes: attributes,
 	}
 }
 
+// ValidateConfig validates the trusted keys.
+func (d *commitDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config commitDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSignatureConfig(config.signatureModel)...)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *commitDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state commitDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	hashArg := state.Hash.ValueString()
 	hash := plumbing.NewHash((hashArg))
 
-	commit, err := d.repo.CommitObject(hash)
+	commit, err := repo.CommitObject(hash)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Commit `"+hashArg+"`",
@@ -81,11 +129,45 @@ func (d *commitDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	mailmap, err := readMailmap(repo, state.UseMailmap)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Mailmap",
+			err.Error(),
+		)
+		return
+	}
+
+	tokens, err := repositoryTrailerTokens(repo)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Trailer Configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	authorName, authorEmail := mailmap.resolveSignature(commit.Author)
+	committerName, committerEmail := mailmap.resolveSignature(commit.Committer)
+
+	state.AuthorEmail = types.StringValue(authorEmail)
+	state.AuthorName = types.StringValue(authorName)
+	state.CommitterEmail = types.StringValue(committerEmail)
+	state.CommitterName = types.StringValue(committerName)
 	state.Date = types.StringValue(commit.Author.When.Format(time.RFC3339))
 	state.Message = types.StringValue(commit.Message)
+	state.trailersModel = newTrailersModel(commit.Message, tokens)
+
+	keys, diags := readTrustedKeys(state.signatureModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	verifySignature(&state.signatureModel, commitSignedObject(commit), keys)
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -100,15 +182,15 @@ func (d *commitDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	repo, ok := req.ProviderData.(*git.Repository)
+	data, ok := req.ProviderData.(*gitlocalProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *git.Repository, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.repo = repo
+	d.data = data
 }
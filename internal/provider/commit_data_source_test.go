@@ -5,10 +5,16 @@ package provider
 
 import (
 	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/go-git/go-git/v5"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestCommitDataSource(t *testing.T) {
@@ -38,3 +44,298 @@ func TestCommitDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestCommitDataSourceSignature(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "unsigned")
+	unsigned := testAccCommitHashes(t, fixture)[0]
+
+	signer, signerKey := testAccPGPEntity(t, "Fixture Signer", "signer@example.com")
+	_, otherKey := testAccPGPEntity(t, "Other Signer", "other@example.com")
+
+	signed := testAccSignedCommit(t, fixture, signer, nil)
+
+	keyringFile := filepath.Join(t.TempDir(), "keyring.asc")
+	if err := os.WriteFile(keyringFile, []byte(signerKey), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %[1]q
+}
+
+data "gitlocal_commit" "trusted" {
+  hash    = %[2]q
+  keyring = %[4]q
+}
+
+data "gitlocal_commit" "trusted_file" {
+  hash         = %[2]q
+  keyring_file = %[6]q
+}
+
+data "gitlocal_commit" "untrusted" {
+  hash    = %[2]q
+  keyring = %[5]q
+}
+
+data "gitlocal_commit" "unsigned" {
+  hash    = %[3]q
+  keyring = %[4]q
+}
+`, fixture, signed, unsigned, signerKey, otherKey, keyringFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.gitlocal_commit.trusted", "signature", regexp.MustCompile("^-----BEGIN PGP SIGNATURE-----")),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trusted", "signature_verified", "true"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trusted", "signer_key_id", signer.PrimaryKey.KeyIdString()),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trusted", "signer_identity", "Fixture Signer <signer@example.com>"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trusted_file", "signature_verified", "true"),
+
+					resource.TestCheckResourceAttrSet("data.gitlocal_commit.untrusted", "signature"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.untrusted", "signature_verified", "false"),
+					resource.TestCheckNoResourceAttr("data.gitlocal_commit.untrusted", "signer_key_id"),
+
+					resource.TestCheckNoResourceAttr("data.gitlocal_commit.unsigned", "signature"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.unsigned", "signature_verified", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %[1]q
+}
+
+data "gitlocal_commit" "test" {
+  hash         = %[2]q
+  keyring      = %[3]q
+  keyring_file = %[4]q
+}
+`, fixture, signed, signerKey, keyringFile),
+				ExpectError: regexp.MustCompile("Conflicting Keyring Configuration"),
+			},
+		},
+	})
+}
+
+func TestCommitDataSourceSSHSignature(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "unsigned")
+
+	signer, publicKey := testAccNewSSHSigner(t)
+	_, otherKey := testAccNewSSHSigner(t)
+
+	signed := testAccSignedCommit(t, fixture, nil, signer)
+
+	allowedSignersFile := filepath.Join(t.TempDir(), "allowed_signers")
+	if err := os.WriteFile(allowedSignersFile, []byte("signer@example.com namespaces=\"git\" "+publicKey+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %[1]q
+}
+
+data "gitlocal_commit" "trusted" {
+  hash                 = %[2]q
+  allowed_signers_file = %[3]q
+}
+
+data "gitlocal_commit" "untrusted" {
+  hash            = %[2]q
+  allowed_signers = "other@example.com %[4]s"
+}
+`, fixture, signed, allowedSignersFile, otherKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.gitlocal_commit.trusted", "signature", regexp.MustCompile("^-----BEGIN SSH SIGNATURE-----")),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trusted", "signature_verified", "true"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trusted", "signer_identity", "signer@example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trusted", "signer_key_id", ssh.FingerprintSHA256(signer.signer.PublicKey())),
+
+					resource.TestCheckResourceAttr("data.gitlocal_commit.untrusted", "signature_verified", "false"),
+					resource.TestCheckNoResourceAttr("data.gitlocal_commit.untrusted", "signer_identity"),
+				),
+			},
+		},
+	})
+}
+
+func TestCommitDataSourceTrailers(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	plain := testAccCommitHashes(t, fixture)[0]
+
+	commits := testAccCommitMessages(t, fixture,
+		"Deploy the api\n\nChange-Ticket: CHG-42\nCo-authored-by: Jane Doe <jane@example.com>\nco-authored-by: Release Bot\nSigned-off-by: Fixture Author <fixture@example.com>\n",
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_commit" "trailers" {
+  hash = %q
+
+  lifecycle {
+    postcondition {
+      condition     = contains([for trailer in self.trailers : trailer.key], "Change-Ticket")
+      error_message = "Commits must reference a change ticket."
+    }
+  }
+}
+
+data "gitlocal_commit" "plain" {
+  hash = %q
+}
+`, fixture, commits[0], plain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trailers", "trailers.#", "4"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trailers", "trailers.0.key", "Change-Ticket"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trailers", "trailers.0.value", "CHG-42"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trailers", "trailers.3.key", "Signed-off-by"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trailers", "co_authors.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trailers", "co_authors.0.name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trailers", "co_authors.0.email", "jane@example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.trailers", "co_authors.1.name", "Release Bot"),
+					resource.TestCheckNoResourceAttr("data.gitlocal_commit.trailers", "co_authors.1.email"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_commit.plain", "trailers.#", "0"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.plain", "co_authors.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_commit" "plain" {
+  hash = %q
+
+  lifecycle {
+    postcondition {
+      condition     = contains([for trailer in self.trailers : trailer.key], "Change-Ticket")
+      error_message = "Commits must reference a change ticket."
+    }
+  }
+}
+`, fixture, plain),
+				ExpectError: regexp.MustCompile(`Commits must reference a change ticket`),
+			},
+		},
+	})
+}
+
+func TestCommitDataSourceMailmap(t *testing.T) {
+	fixture := testAccMailmapFixture(t)
+	commits := testAccCommitHashes(t, fixture)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_commit" "mapped" {
+  hash = %q
+}
+
+data "gitlocal_commit" "unmapped" {
+  hash        = %[2]q
+  use_mailmap = false
+}
+`, fixture, commits[1]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_commit.mapped", "author_name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.mapped", "author_email", "jane@example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.mapped", "committer_name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.mapped", "committer_email", "jane@example.com"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_commit.unmapped", "author_name", "jane"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.unmapped", "author_email", "jane@work.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestCommitDataSourceMailmapConfig(t *testing.T) {
+	fixture := testAccMailmapFixture(t)
+	commits := testAccCommitHashes(t, fixture)
+
+	// The blob maps the name and the file in the home directory the email of
+	// the same identity, on top of the .mailmap of the worktree.
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	if err := os.WriteFile(filepath.Join(home, "people.mailmap"), []byte("<maintainer@example.com> <fixture@example.com>\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitFile(t, worktree, "people.mailmap", "Fixture Maintainer <fixture@example.com>\n")
+
+	cfg, err := repo.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.Raw.Section("mailmap").SetOption("blob", "HEAD:people.mailmap")
+	cfg.Raw.Section("mailmap").SetOption("file", "~/people.mailmap")
+
+	if err := repo.SetConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_commit" "fixture" {
+  hash = %q
+}
+
+data "gitlocal_commit" "jane" {
+  hash = %q
+}
+`, fixture, commits[2], commits[0]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_commit.fixture", "author_name", "Fixture Maintainer"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.fixture", "author_email", "maintainer@example.com"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_commit.jane", "author_name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.gitlocal_commit.jane", "author_email", "jane@example.com"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &contributorsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &contributorsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &contributorsDataSource{}
+)
+
+// NewContributorsDataSource is a helper function to simplify the provider implementation.
+func NewContributorsDataSource() datasource.DataSource {
+	return &contributorsDataSource{}
+}
+
+// contributorsDataSource is the data source implementation.
+type contributorsDataSource struct {
+	data *gitlocalProviderData
+}
+
+// contributorsDataSourceModel maps the data source schema data.
+type contributorsDataSourceModel struct {
+	Contributors   []contributorModel `tfsdk:"contributors"`
+	From           types.String       `tfsdk:"from"`
+	Paths          []types.String     `tfsdk:"paths"`
+	RepositoryPath types.String       `tfsdk:"repository_path"`
+	To             types.String       `tfsdk:"to"`
+	UseMailmap     types.Bool         `tfsdk:"use_mailmap"`
+}
+
+// contributorModel maps contributor schema data.
+type contributorModel struct {
+	Additions       types.Int64  `tfsdk:"additions"`
+	Commits         types.Int64  `tfsdk:"commits"`
+	Deletions       types.Int64  `tfsdk:"deletions"`
+	Email           types.String `tfsdk:"email"`
+	FirstCommitDate types.String `tfsdk:"first_commit_date"`
+	LastCommitDate  types.String `tfsdk:"last_commit_date"`
+	Name            types.String `tfsdk:"name"`
+}
+
+// Metadata returns the data source type name.
+func (d *contributorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contributors"
+}
+
+// Schema defines the schema for the data source.
+func (d *contributorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Aggregates the commits between two revisions by author, as `git shortlog --summary --email` does, with the lines each author added and deleted.",
+		Attributes: map[string]schema.Attribute{
+			"contributors": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Authors of the commits, ordered by decreasing number of commits then by email",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"additions": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of lines added by the author in the files matched by `paths`. Merge commits are not counted",
+						},
+						"commits": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of commits of the author, including merge commits",
+						},
+						"deletions": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of lines deleted by the author in the files matched by `paths`. Merge commits are not counted",
+						},
+						"email": schema.StringAttribute{
+							Computed:    true,
+							Description: "Email of the author, compared case-insensitively",
+						},
+						"first_commit_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "Author date of the earliest commit of the author in RFC 3339",
+						},
+						"last_commit_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "Author date of the latest commit of the author in RFC 3339",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the author, as recorded on their latest commit",
+						},
+					},
+				},
+			},
+			"from": schema.StringAttribute{
+				Description: "Revision the range starts after, such as the tag of the previous release. Defaults to the first commit",
+				Optional:    true,
+			},
+			"paths": schema.ListAttribute{
+				Description: "Only count commits changing these files or directories, relative to the root of the repository. " +
+					"Entries can be glob patterns, where `**` matches any number of directories. Defaults to every commit",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"repository_path": RepositoryPathAttribute(),
+			"to": schema.StringAttribute{
+				Description: "Revision the range ends at. Defaults to `HEAD`",
+				Optional:    true,
+			},
+			"use_mailmap": MailmapAttribute(),
+		},
+	}
+}
+
+// ValidateConfig validates the path patterns.
+func (d *contributorsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config contributorsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, p := range config.Paths {
+		if p.IsNull() || p.IsUnknown() {
+			continue
+		}
+
+		if err := validateGlob(p.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("paths").AtListIndex(i),
+				"Invalid Path Pattern",
+				"The pattern `"+p.ValueString()+"` is not a valid glob: "+err.Error(),
+			)
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *contributorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state contributorsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	to, diags := resolveCommitAttribute(repo, path.Root("to"), state.To)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since := plumbing.ZeroHash
+	if !state.From.IsNull() {
+		from, diags := resolveCommitAttribute(repo, path.Root("from"), state.From)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		since = from.Hash
+	}
+
+	mailmap, err := readMailmap(repo, state.UseMailmap)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Mailmap",
+			err.Error(),
+		)
+		return
+	}
+
+	paths := stringValues(state.Paths, nil)
+
+	var pathFilter func(string) bool
+	if state.Paths != nil {
+		pathFilter = func(name string) bool {
+			return matchPathspecs(paths, name)
+		}
+	}
+
+	commits, err := commitsSince(repo, to, since, pathFilter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Commits",
+			err.Error(),
+		)
+		return
+	}
+
+	// Boundary commits of a shallow clone have no parents to diff against,
+	// so their changes are read against the empty tree as git does.
+	shallow, err := repo.Storer.Shallow()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Shallow Commits",
+			err.Error(),
+		)
+		return
+	}
+
+	contributors := map[string]*contributorModel{}
+	firstDates := map[string]time.Time{}
+	lastDates := map[string]time.Time{}
+
+	for _, commit := range commits {
+		name, email := mailmap.resolveSignature(commit.Author)
+		key := strings.ToLower(email)
+		when := commit.Author.When
+
+		contributor, ok := contributors[key]
+		if !ok {
+			contributor = &contributorModel{
+				Additions: types.Int64Value(0),
+				Commits:   types.Int64Value(0),
+				Deletions: types.Int64Value(0),
+				Email:     types.StringValue(email),
+			}
+			contributors[key] = contributor
+		}
+
+		contributor.Commits = types.Int64Value(contributor.Commits.ValueInt64() + 1)
+
+		if !ok || when.Before(firstDates[key]) {
+			contributor.FirstCommitDate = types.StringValue(when.Format(time.RFC3339))
+			firstDates[key] = when
+		}
+
+		if !ok || when.After(lastDates[key]) {
+			contributor.LastCommitDate = types.StringValue(when.Format(time.RFC3339))
+			contributor.Name = types.StringValue(name)
+			lastDates[key] = when
+		}
+
+		if commit.NumParents() > 1 {
+			continue
+		}
+
+		stats, err := commitStats(ctx, commit, shallow)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Changes of `"+commit.Hash.String()+"`",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, stat := range stats {
+			if !matchPathspecs(paths, stat.Name) {
+				continue
+			}
+
+			contributor.Additions = types.Int64Value(contributor.Additions.ValueInt64() + int64(stat.Addition))
+			contributor.Deletions = types.Int64Value(contributor.Deletions.ValueInt64() + int64(stat.Deletion))
+		}
+	}
+
+	state.Contributors = []contributorModel{}
+
+	for _, contributor := range contributors {
+		state.Contributors = append(state.Contributors, *contributor)
+	}
+
+	sort.Slice(state.Contributors, func(i, j int) bool {
+		if state.Contributors[i].Commits.ValueInt64() != state.Contributors[j].Commits.ValueInt64() {
+			return state.Contributors[i].Commits.ValueInt64() > state.Contributors[j].Commits.ValueInt64()
+		}
+
+		return state.Contributors[i].Email.ValueString() < state.Contributors[j].Email.ValueString()
+	})
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// commitStats returns the changes of commit against its first parent, or the
+// empty tree when it has none or is one of the shallow boundary commits.
+func commitStats(ctx context.Context, commit *object.Commit, shallow []plumbing.Hash) (object.FileStats, error) {
+	if !slices.Contains(shallow, commit.Hash) {
+		return commit.StatsContext(ctx)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := (&object.Tree{}).PatchContext(ctx, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	return patch.Stats(), nil
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *contributorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestContributorsDataSource(t *testing.T) {
+	fixture := testAccMailmapFixture(t)
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitFile(t, worktree, "README.md", "first\n")
+	testAccCommitFile(t, worktree, "services/api/main.go", "a\nb\nc\n")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_contributors" "all" { }
+
+data "gitlocal_contributors" "api" {
+  paths = ["services/api"]
+}
+
+data "gitlocal_contributors" "range" {
+  from = "HEAD~2"
+  to   = "HEAD~1"
+}
+
+data "gitlocal_contributors" "unmapped" {
+  use_mailmap = false
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.all", "contributors.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.all", "contributors.0.email", "fixture@example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.all", "contributors.0.name", "Fixture Author"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.all", "contributors.0.commits", "3"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.all", "contributors.0.additions", "5"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.all", "contributors.0.deletions", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.all", "contributors.1.email", "jane@example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.all", "contributors.1.name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.all", "contributors.1.commits", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.all", "contributors.1.additions", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.all", "contributors.1.first_commit_date", "2025-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.all", "contributors.1.last_commit_date", "2025-01-02T04:04:05Z"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.api", "contributors.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.api", "contributors.0.commits", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.api", "contributors.0.additions", "3"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.api", "contributors.0.deletions", "0"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.range", "contributors.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.range", "contributors.0.commits", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.range", "contributors.0.deletions", "1"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.unmapped", "contributors.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestContributorsDataSourceShallow(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first\n", "first\nsecond\n")
+	boundary := testAccCommitHashes(t, fixture)[1]
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitFile(t, worktree, "services/api/main.go", "a\nb\nc\n")
+	testAccShallowFixture(t, fixture, plumbing.NewHash(boundary))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_contributors" "test" { }
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.test", "contributors.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.test", "contributors.0.commits", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.test", "contributors.0.additions", "5"),
+					resource.TestCheckResourceAttr("data.gitlocal_contributors.test", "contributors.0.deletions", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+)
+
+// conventionalHeaderPattern matches the header of a Conventional Commit, such
+// as `feat(api)!: add an endpoint`.
+var conventionalHeaderPattern = regexp.MustCompile(`^([A-Za-z][\w-]*)(?:\(([^()\r\n]*)\))?(!)?: +(\S.*?)\s*$`)
+
+// conventionalBreakingPattern matches a footer announcing a breaking change.
+var conventionalBreakingPattern = regexp.MustCompile(`(?m)^BREAKING[ -]CHANGE: `)
+
+// conventionalCommit is a commit message following the Conventional Commits
+// specification, https://www.conventionalcommits.org.
+type conventionalCommit struct {
+	breaking    bool
+	description string
+	scope       string
+	typ         string
+}
+
+// parseConventionalCommit parses message as a Conventional Commit, and returns
+// false when its header does not follow the specification. The type is
+// returned in lower case.
+func parseConventionalCommit(message string) (conventionalCommit, bool) {
+	header, body, _ := strings.Cut(message, "\n")
+
+	match := conventionalHeaderPattern.FindStringSubmatch(header)
+	if match == nil {
+		return conventionalCommit{}, false
+	}
+
+	return conventionalCommit{
+		breaking:    match[3] == "!" || conventionalBreakingPattern.MatchString(body),
+		description: match[4],
+		scope:       strings.TrimSpace(match[2]),
+		typ:         strings.ToLower(match[1]),
+	}, true
+}
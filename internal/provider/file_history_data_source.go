@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	pathpkg "path"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &fileHistoryDataSource{}
+	_ datasource.DataSourceWithConfigure      = &fileHistoryDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &fileHistoryDataSource{}
+)
+
+// NewFileHistoryDataSource is a helper function to simplify the provider implementation.
+func NewFileHistoryDataSource() datasource.DataSource {
+	return &fileHistoryDataSource{}
+}
+
+// fileHistoryDataSource is the data source implementation.
+type fileHistoryDataSource struct {
+	data *gitlocalProviderData
+}
+
+// fileHistoryDataSourceModel maps the data source schema data.
+type fileHistoryDataSourceModel struct {
+	AuthorEmail    types.String             `tfsdk:"author_email"`
+	AuthorName     types.String             `tfsdk:"author_name"`
+	Commits        []fileHistoryCommitModel `tfsdk:"commits"`
+	Date           types.String             `tfsdk:"date"`
+	Hash           types.String             `tfsdk:"hash"`
+	MaxEntries     types.Int64              `tfsdk:"max_entries"`
+	Message        types.String             `tfsdk:"message"`
+	Path           types.String             `tfsdk:"path"`
+	RepositoryPath types.String             `tfsdk:"repository_path"`
+	Revision       types.String             `tfsdk:"revision"`
+	UseMailmap     types.Bool               `tfsdk:"use_mailmap"`
+}
+
+// fileHistoryCommitModel maps commit schema data.
+type fileHistoryCommitModel struct {
+	AuthorEmail types.String `tfsdk:"author_email"`
+	AuthorName  types.String `tfsdk:"author_name"`
+	Date        types.String `tfsdk:"date"`
+	Hash        types.String `tfsdk:"hash"`
+	Message     types.String `tfsdk:"message"`
+
+	trailersModel
+}
+
+// Metadata returns the data source type name.
+func (d *fileHistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_history"
+}
+
+// Schema defines the schema for the data source.
+func (d *fileHistoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	commitAttributes := TrailerAttributes()
+	commitAttributes["author_email"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Email of the author of the commit",
+	}
+	commitAttributes["author_name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the author of the commit",
+	}
+	commitAttributes["date"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Author date of the commit in RFC 3339",
+	}
+	commitAttributes["hash"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Hash of the commit",
+	}
+	commitAttributes["message"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Message of the commit",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Finds the most recent commits that changed a file or any file under a directory, as `git log -- <path>` does.",
+		Attributes: map[string]schema.Attribute{
+			"author_email": schema.StringAttribute{
+				Computed:    true,
+				Description: "Email of the author of the most recent commit",
+			},
+			"author_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the author of the most recent commit",
+			},
+			"commits": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Most recent commits that changed the path, most recent first and at most `max_entries`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: commitAttributes,
+				},
+			},
+			"date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Author date of the most recent commit in RFC 3339",
+			},
+			"hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the most recent commit that changed the path",
+			},
+			"max_entries": schema.Int64Attribute{
+				Description: "Maximum number of commits to return in `commits`. Defaults to 1",
+				Optional:    true,
+			},
+			"message": schema.StringAttribute{
+				Computed:    true,
+				Description: "Message of the most recent commit",
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of a file or directory, relative to the root of the repository",
+				Required:    true,
+			},
+			"repository_path": RepositoryPathAttribute(),
+			"revision":        RevisionAttribute(),
+			"use_mailmap":     MailmapAttribute(),
+		},
+	}
+}
+
+// ValidateConfig validates the entry limit.
+func (d *fileHistoryDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config fileHistoryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.MaxEntries.IsNull() && !config.MaxEntries.IsUnknown() && config.MaxEntries.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_entries"),
+			"Invalid Maximum Entries",
+			"The maximum number of entries must be at least 1.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *fileHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state fileHistoryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commit, diags := resolveCommit(repo, state.Revision)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mailmap, err := readMailmap(repo, state.UseMailmap)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Mailmap",
+			err.Error(),
+		)
+		return
+	}
+
+	tokens, err := repositoryTrailerTokens(repo)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Trailer Configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	maxEntries := int64(1)
+	if !state.MaxEntries.IsNull() {
+		maxEntries = state.MaxEntries.ValueInt64()
+	}
+
+	commits, err := logCommits(repo, commit, pathspecFilter(state.Path.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read History of `"+state.Path.ValueString()+"`",
+			err.Error(),
+		)
+		return
+	}
+	defer commits.Close()
+
+	state.Commits = nil
+
+	for int64(len(state.Commits)) < maxEntries {
+		c, err := commits.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read History of `"+state.Path.ValueString()+"`",
+				err.Error(),
+			)
+			return
+		}
+
+		state.Commits = append(state.Commits, newFileHistoryCommitModel(c, mailmap, tokens))
+	}
+
+	if len(state.Commits) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"No Commit Found",
+			"No commit reachable from `"+commit.Hash.String()+"` changed `"+state.Path.ValueString()+"`.",
+		)
+		return
+	}
+
+	state.AuthorEmail = state.Commits[0].AuthorEmail
+	state.AuthorName = state.Commits[0].AuthorName
+	state.Date = state.Commits[0].Date
+	state.Hash = state.Commits[0].Hash
+	state.Message = state.Commits[0].Message
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *fileHistoryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+func newFileHistoryCommitModel(commit *object.Commit, mailmap mailmap, trailerTokens []string) fileHistoryCommitModel {
+	authorName, authorEmail := mailmap.resolveSignature(commit.Author)
+
+	return fileHistoryCommitModel{
+		AuthorEmail: types.StringValue(authorEmail),
+		AuthorName:  types.StringValue(authorName),
+		Date:        types.StringValue(commit.Author.When.Format(time.RFC3339)),
+		Hash:        types.StringValue(commit.Hash.String()),
+		Message:     types.StringValue(commit.Message),
+
+		trailersModel: newTrailersModel(commit.Message, trailerTokens),
+	}
+}
+
+// pathspecFilter returns a filter matching the file at p, or every file under
+// it when it is a directory. The root of the repository matches every file.
+func pathspecFilter(p string) func(string) bool {
+	p = pathpkg.Clean("/" + p)[1:]
+
+	if p == "" {
+		return func(string) bool { return true }
+	}
+
+	return func(name string) bool {
+		return name == p || strings.HasPrefix(name, p+"/")
+	}
+}
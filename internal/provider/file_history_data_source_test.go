@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFileHistoryDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitFile(t, worktree, "services/api/main.go", "api")
+	testAccCommitFile(t, worktree, "services/web/main.go", "web")
+	testAccCommitFile(t, worktree, "services/api/handlers/health.go", "health")
+	testAccCommitFile(t, worktree, "README.md", "second")
+
+	commits := testAccCommitHashes(t, fixture)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_file_history" "api" {
+  path = "services/api"
+}
+
+data "gitlocal_file_history" "api_recent" {
+  path        = "services/api/"
+  max_entries = 5
+}
+
+data "gitlocal_file_history" "readme" {
+  path     = "README.md"
+  revision = "HEAD~1"
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api", "hash", commits[3]),
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api", "message", "Update services/api/handlers/health.go\n"),
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api", "author_name", "Fixture Author"),
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api", "author_email", "fixture@example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api", "date", "2025-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api", "commits.#", "1"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api_recent", "commits.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api_recent", "commits.0.hash", commits[3]),
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api_recent", "commits.1.hash", commits[1]),
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api_recent", "commits.0.trailers.#", "0"),
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api_recent", "commits.0.co_authors.#", "0"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.readme", "hash", commits[0]),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_file_history" "missing" {
+  path = "services/worker"
+}
+`, fixture),
+				ExpectError: regexp.MustCompile("No Commit Found"),
+			},
+		},
+	})
+}
+
+func TestFileHistoryDataSourceShallow(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitFile(t, worktree, "services/api/main.go", "api")
+	testAccCommitFile(t, worktree, "services/web/main.go", "web")
+	testAccCommitFile(t, worktree, "services/api/handlers/health.go", "health")
+
+	commits := testAccCommitHashes(t, fixture)
+	testAccShallowFixture(t, fixture, plumbing.NewHash(commits[2]))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_file_history" "api" {
+  path        = "services/api"
+  max_entries = 5
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api", "commits.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api", "commits.0.hash", commits[3]),
+					resource.TestCheckResourceAttr("data.gitlocal_file_history.api", "commits.1.hash", commits[2]),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitattributes"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+const gitattributesFileName = ".gitattributes"
+
+// builtinAttributeMacros are the macros git defines before reading any
+// .gitattributes file.
+const builtinAttributeMacros = "[attr]binary -diff -merge -text\n"
+
+// attributesReader evaluates the .gitattributes files committed at a
+// revision, reading each directory's file at most once.
+type attributesReader struct {
+	commit *object.Commit
+	files  map[string][]gitattributes.MatchAttribute
+	macros map[string]gitattributes.MatchAttribute
+}
+
+// newAttributesReader returns a reader of the .gitattributes files of commit,
+// with the macros of the built-in set and of the root file, the only one git
+// reads macros from.
+func newAttributesReader(commit *object.Commit) (*attributesReader, error) {
+	r := &attributesReader{
+		commit: commit,
+		files:  map[string][]gitattributes.MatchAttribute{},
+		macros: map[string]gitattributes.MatchAttribute{},
+	}
+
+	builtin, err := parseAttributes(strings.NewReader(builtinAttributeMacros), nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := r.read(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attr := range append(builtin, root...) {
+		if attr.Pattern == nil {
+			r.macros[attr.Name] = attr
+		}
+	}
+
+	return r, nil
+}
+
+// read returns the lines of the .gitattributes file in the directory with
+// the given path segments, or nil when it does not exist.
+func (r *attributesReader) read(dir []string) ([]gitattributes.MatchAttribute, error) {
+	key := strings.Join(dir, "/")
+	if attrs, ok := r.files[key]; ok {
+		return attrs, nil
+	}
+
+	file, err := r.commit.File(path.Join(key, gitattributesFileName))
+	if errors.Is(err, object.ErrFileNotFound) {
+		r.files[key] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := file.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	attrs, err := parseAttributes(reader, slices.Clone(dir), len(dir) == 0)
+	if err != nil {
+		return nil, err
+	}
+
+	r.files[key] = attrs
+	return attrs, nil
+}
+
+// attributes returns the attributes of name, a path relative to the root of
+// the repository, as `git check-attr --all` does: `set`, `unset` or the value
+// of each specified attribute, with the attributes of set macros expanded.
+func (r *attributesReader) attributes(name string) (map[string]string, error) {
+	segments := strings.Split(strings.Trim(name, "/"), "/")
+
+	// Files in deeper directories take precedence, as do later lines.
+	var stack []gitattributes.MatchAttribute
+	for i := 0; i < len(segments); i++ {
+		attrs, err := r.read(segments[:i])
+		if err != nil {
+			return nil, err
+		}
+
+		stack = append(stack, attrs...)
+	}
+
+	decided := map[string]gitattributes.Attribute{}
+	for i := len(stack) - 1; i >= 0; i-- {
+		if stack[i].Pattern != nil && stack[i].Pattern.Match(segments) {
+			r.fill(stack[i].Attributes, decided)
+		}
+	}
+
+	values := map[string]string{}
+	for name, attr := range decided {
+		switch {
+		case attr.IsSet():
+			values[name] = "set"
+		case attr.IsUnset():
+			values[name] = "unset"
+		case attr.IsValueSet():
+			values[name] = attr.Value()
+		}
+	}
+
+	return values, nil
+}
+
+// fill records the attributes not decided yet, the last one of a line first,
+// and expands the macros they set.
+func (r *attributesReader) fill(attrs []gitattributes.Attribute, decided map[string]gitattributes.Attribute) {
+	for i := len(attrs) - 1; i >= 0; i-- {
+		if _, ok := decided[attrs[i].Name()]; ok {
+			continue
+		}
+
+		decided[attrs[i].Name()] = attrs[i]
+
+		if macro, ok := r.macros[attrs[i].Name()]; ok && attrs[i].IsSet() {
+			r.fill(macro.Attributes, decided)
+		}
+	}
+}
+
+// parseAttributes returns the lines of the .gitattributes file read from
+// reader, whose patterns are relative to the domain directory. Invalid lines,
+// and macros outside of the root file, are ignored as git does.
+func parseAttributes(reader io.Reader, domain []string, allowMacro bool) ([]gitattributes.MatchAttribute, error) {
+	var attrs []gitattributes.MatchAttribute
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		attr, err := gitattributes.ParseAttributesLine(scanner.Text(), domain, allowMacro)
+		if err != nil || attr.Name == "" {
+			continue
+		}
+
+		attrs = append(attrs, attr)
+	}
+
+	return attrs, scanner.Err()
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+// worktreeIgnoreMatcher returns a matcher for the files git ignores in the
+// worktree: core.excludesFile, then the info/exclude file of the repository
+// and the .gitignore files of the worktree, in increasing precedence.
+func worktreeIgnoreMatcher(repo *git.Repository, worktree *git.Worktree) (gitignore.Matcher, error) {
+	excludesFile, err := repositoryExcludesFile(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	patterns, err := readIgnoreFile(excludesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	gitDir, err := repositoryGitDir(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Linked worktrees share the info/exclude file of the main repository.
+	commonDir, err := readCommonDir(gitDir)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude, err := readIgnoreFile(filepath.Join(commonDir, "info", "exclude"))
+	if err != nil {
+		return nil, err
+	}
+	patterns = append(patterns, exclude...)
+
+	local, err := gitignore.ReadPatterns(worktree.Filesystem, nil)
+	if err != nil {
+		return nil, err
+	}
+	patterns = append(patterns, local...)
+	patterns = append(patterns, worktree.Excludes...)
+
+	return gitignore.NewMatcher(patterns), nil
+}
+
+// matchIgnored reports whether git ignores name, a slash separated path
+// relative to the root of the worktree, which is a directory when isDir is set
+// or it ends with a slash. Everything under an ignored directory is ignored,
+// as git does not descend into it.
+func matchIgnored(matcher gitignore.Matcher, name string, isDir bool) bool {
+	isDir = isDir || strings.HasSuffix(name, "/")
+	segments := strings.Split(strings.Trim(name, "/"), "/")
+
+	for i := 1; i <= len(segments); i++ {
+		if matcher.Match(segments[:i], i < len(segments) || isDir) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// repositoryExcludesFile returns the path of the core.excludesFile of repo,
+// from the repository, user then system configuration, defaulting to
+// git/ignore in the XDG configuration directory as git does.
+func repositoryExcludesFile(repo *git.Repository) (string, error) {
+	configs, err := repositoryConfigs(repo)
+	if err != nil {
+		return "", err
+	}
+
+	for _, cfg := range configs {
+		if excludesFile := cfg.Raw.Section("core").Option("excludesfile"); excludesFile != "" {
+			return expandHomePath(excludesFile)
+		}
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		return filepath.Join(xdg, "git", "ignore"), nil
+	}
+
+	return filepath.Join(home, ".config", "git", "ignore"), nil
+}
+
+// readIgnoreFile returns the patterns of the gitignore formatted file at
+// name, relative to the root of the worktree, or nil when it does not exist.
+func readIgnoreFile(name string) ([]gitignore.Pattern, error) {
+	file, err := os.Open(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var patterns []gitignore.Pattern
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		patterns = append(patterns, gitignore.ParsePattern(line, nil))
+	}
+
+	return patterns, scanner.Err()
+}
+
+// worktreeIsDir reports whether name, a slash separated path relative to the
+// root of the worktree, is an existing directory.
+func worktreeIsDir(worktree *git.Worktree, name string) bool {
+	info, err := worktree.Filesystem.Lstat(name)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestMatchGlob(t *testing.T) {
+	for _, test := range []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{"refs/pull/*", "refs/pull/1", true},
+		{"refs/pull/*", "refs/pull/1/head", false},
+		{"refs/pull/*/head", "refs/pull/1/head", true},
+		{"refs/deploy/**", "refs/deploy/production/web", true},
+		{"refs/deploy/**", "refs/deploy", true},
+		{"refs/**/web", "refs/deploy/production/web", true},
+		{"refs/**/web", "refs/web", true},
+		{"refs/heads/release-*", "refs/heads/release-1.0", true},
+		{"refs/heads/release-*", "refs/heads/main", false},
+		{"HEAD", "HEAD", true},
+		{"services/**/*.go", "services/api/main.go", true},
+		{"services/*.go", "services/api/main.go", false},
+	} {
+		if got := matchGlob(test.pattern, test.name); got != test.match {
+			t.Errorf("matchGlob(%q, %q) = %t, want %t", test.pattern, test.name, got, test.match)
+		}
+	}
+
+	if err := validateGlob("refs/[pull/*"); err == nil {
+		t.Error("expected an error for a malformed pattern")
+	}
+}
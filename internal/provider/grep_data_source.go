@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &grepDataSource{}
+	_ datasource.DataSourceWithConfigure      = &grepDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &grepDataSource{}
+)
+
+// NewGrepDataSource is a helper function to simplify the provider implementation.
+func NewGrepDataSource() datasource.DataSource {
+	return &grepDataSource{}
+}
+
+// grepDataSource is the data source implementation.
+type grepDataSource struct {
+	data *gitlocalProviderData
+}
+
+// grepDataSourceModel maps the data source schema data.
+type grepDataSourceModel struct {
+	IgnoreCase     types.Bool       `tfsdk:"ignore_case"`
+	Matches        []grepMatchModel `tfsdk:"matches"`
+	Paths          []types.String   `tfsdk:"paths"`
+	Pattern        types.String     `tfsdk:"pattern"`
+	RepositoryPath types.String     `tfsdk:"repository_path"`
+	Revision       types.String     `tfsdk:"revision"`
+}
+
+// grepMatchModel maps match schema data.
+type grepMatchModel struct {
+	LineNumber types.Int64  `tfsdk:"line_number"`
+	Path       types.String `tfsdk:"path"`
+	Text       types.String `tfsdk:"text"`
+}
+
+// Metadata returns the data source type name.
+func (d *grepDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grep"
+}
+
+// Schema defines the schema for the data source.
+func (d *grepDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Searches the files committed at a revision for lines matching a regular expression, as `git grep` does. Binary files are skipped.",
+		Attributes: map[string]schema.Attribute{
+			"ignore_case": schema.BoolAttribute{
+				Description: "Whether to ignore case when matching `pattern`. Defaults to `false`",
+				Optional:    true,
+			},
+			"matches": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Lines matching `pattern`, ordered by path then line number",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"line_number": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of the line in the file, starting at 1",
+						},
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Path of the file, relative to the root of the repository",
+						},
+						"text": schema.StringAttribute{
+							Computed:    true,
+							Description: "Text of the line, without the line ending",
+						},
+					},
+				},
+			},
+			"paths": schema.ListAttribute{
+				Description: "Files or directories to search, relative to the root of the repository. " +
+					"Entries can be glob patterns, where `**` matches any number of directories. Defaults to every file",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"pattern": schema.StringAttribute{
+				Description: "Regular expression to match against each line, in the [RE2 syntax](https://github.com/google/re2/wiki/Syntax)",
+				Required:    true,
+			},
+			"repository_path": RepositoryPathAttribute(),
+			"revision":        RevisionAttribute(),
+		},
+	}
+}
+
+// ValidateConfig validates the regular expression and the path patterns.
+func (d *grepDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config grepDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Pattern.IsNull() && !config.Pattern.IsUnknown() {
+		if _, err := regexp.Compile(config.Pattern.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("pattern"),
+				"Invalid Pattern",
+				"The pattern `"+config.Pattern.ValueString()+"` is not a valid regular expression: "+err.Error(),
+			)
+		}
+	}
+
+	for i, p := range config.Paths {
+		if p.IsNull() || p.IsUnknown() {
+			continue
+		}
+
+		if err := validateGlob(p.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("paths").AtListIndex(i),
+				"Invalid Path Pattern",
+				"The pattern `"+p.ValueString()+"` is not a valid glob: "+err.Error(),
+			)
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *grepDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state grepDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commit, diags := resolveCommit(repo, state.Revision)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expr := state.Pattern.ValueString()
+	if state.IgnoreCase.ValueBool() {
+		expr = "(?i)" + expr
+	}
+
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pattern"),
+			"Invalid Pattern",
+			"The pattern `"+state.Pattern.ValueString()+"` is not a valid regular expression: "+err.Error(),
+		)
+		return
+	}
+
+	var paths []string
+	for _, p := range state.Paths {
+		paths = append(paths, p.ValueString())
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tree",
+			"The tree of `"+commit.Hash.String()+"` could not be read: "+err.Error(),
+		)
+		return
+	}
+
+	state.Matches = nil
+
+	err = tree.Files().ForEach(func(file *object.File) error {
+		if !matchPathspecs(paths, file.Name) {
+			return nil
+		}
+
+		if binary, err := file.IsBinary(); err != nil || binary {
+			return err
+		}
+
+		lines, err := file.Lines()
+		if err != nil {
+			return err
+		}
+
+		for i, line := range lines {
+			line = strings.TrimSuffix(line, "\r")
+
+			if pattern.MatchString(line) {
+				state.Matches = append(state.Matches, grepMatchModel{
+					LineNumber: types.Int64Value(int64(i + 1)),
+					Path:       types.StringValue(file.Name),
+					Text:       types.StringValue(line),
+				})
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Search Files",
+			"The files of `"+commit.Hash.String()+"` could not be read: "+err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(state.Matches, func(i, j int) bool {
+		return state.Matches[i].Path.ValueString() < state.Matches[j].Path.ValueString()
+	})
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *grepDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// matchPathspecs reports whether name is one of the files or under one of the
+// directories of pathspecs, or matches one of them as a glob. Every name
+// matches when pathspecs is empty.
+func matchPathspecs(pathspecs []string, name string) bool {
+	if len(pathspecs) == 0 {
+		return true
+	}
+
+	for _, pathspec := range pathspecs {
+		if pathspecFilter(pathspec)(name) || matchGlob(pathspec, name) {
+			return true
+		}
+	}
+
+	return false
+}
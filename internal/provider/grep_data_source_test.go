@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGrepDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "# deploy: false\n")
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitFile(t, worktree, "services/api/main.tf", "# Deploy: true\nresource \"null\" \"api\" {}\n")
+	testAccCommitFile(t, worktree, "services/web/main.tf", "resource \"null\" \"web\" {}\r\n# deploy: true\r\n")
+	testAccCommitFile(t, worktree, "services/web/logo.png", "\x89PNG\x00# deploy: true\n")
+	testAccCommitFile(t, worktree, "services/web/main.tf", "resource \"null\" \"web\" {}\r\n# deploy: true\r\n# deploy: true\r\n")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_grep" "deploy" {
+  pattern = "^# deploy: true$"
+}
+
+data "gitlocal_grep" "deploy_ignore_case" {
+  pattern     = "^# deploy: true$"
+  ignore_case = true
+  paths       = ["**/*.tf"]
+}
+
+data "gitlocal_grep" "api" {
+  pattern     = "deploy"
+  ignore_case = true
+  paths       = ["services/api"]
+}
+
+data "gitlocal_grep" "previous" {
+  pattern  = "deploy: true"
+  revision = "HEAD~1"
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_grep.deploy", "matches.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_grep.deploy", "matches.0.path", "services/web/main.tf"),
+					resource.TestCheckResourceAttr("data.gitlocal_grep.deploy", "matches.0.line_number", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_grep.deploy", "matches.0.text", "# deploy: true"),
+					resource.TestCheckResourceAttr("data.gitlocal_grep.deploy", "matches.1.line_number", "3"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_grep.deploy_ignore_case", "matches.#", "3"),
+					resource.TestCheckResourceAttr("data.gitlocal_grep.deploy_ignore_case", "matches.0.path", "services/api/main.tf"),
+					resource.TestCheckResourceAttr("data.gitlocal_grep.deploy_ignore_case", "matches.0.line_number", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_grep.deploy_ignore_case", "matches.0.text", "# Deploy: true"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_grep.api", "matches.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_grep.api", "matches.0.path", "services/api/main.tf"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_grep.previous", "matches.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_grep.previous", "matches.0.path", "services/web/main.tf"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_grep" "invalid" {
+  pattern = "deploy: (true"
+}
+`, fixture),
+				ExpectError: regexp.MustCompile(`Invalid Pattern`),
+			},
+		},
+	})
+}
+
+func TestMatchPathspecs(t *testing.T) {
+	cases := []struct {
+		pathspecs []string
+		name      string
+		want      bool
+	}{
+		{nil, "README.md", true},
+		{[]string{"README.md"}, "README.md", true},
+		{[]string{"services"}, "services/api/main.tf", true},
+		{[]string{"services/"}, "services/api/main.tf", true},
+		{[]string{"services"}, "servicesx/main.tf", false},
+		{[]string{"*.tf"}, "main.tf", true},
+		{[]string{"*.tf"}, "services/main.tf", false},
+		{[]string{"**/*.tf"}, "services/api/main.tf", true},
+		{[]string{"services/*/main.tf"}, "services/api/main.tf", true},
+		{[]string{"docs", "**/*.tf"}, "docs/index.md", true},
+		{[]string{"docs", "**/*.tf"}, "README.md", false},
+	}
+
+	for _, c := range cases {
+		if got := matchPathspecs(c.pathspecs, c.name); got != c.want {
+			t.Errorf("matchPathspecs(%q, %q) = %t, want %t", c.pathspecs, c.name, got, c.want)
+		}
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/go-git/go-git/v5"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -26,12 +25,13 @@ func NewHeadDataSource() datasource.DataSource {
 
 // headDataSource is the data source implementation.
 type headDataSource struct {
-	repo *git.Repository
+	data *gitlocalProviderData
 }
 
 // headDataSourceModel maps the data source schema data.
 type headDataSourceModel struct {
-	Hash types.String `tfsdk:"hash"`
+	Hash           types.String `tfsdk:"hash"`
+	RepositoryPath types.String `tfsdk:"repository_path"`
 }
 
 // coffeesIngredientsModel maps coffee ingredients data
@@ -48,6 +48,7 @@ func (d *headDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Computed:    true,
 				Description: "Hash of the commit",
 			},
+			"repository_path": RepositoryPathAttribute(),
 		},
 	}
 }
@@ -58,7 +59,13 @@ func (d *headDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	head, err := d.repo.Head()
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	head, err := repo.Head()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Git Head",
@@ -70,7 +77,7 @@ func (d *headDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	state.Hash = types.StringValue(head.Hash().String())
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -85,15 +92,15 @@ func (d *headDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 		return
 	}
 
-	repo, ok := req.ProviderData.(*git.Repository)
+	data, ok := req.ProviderData.(*gitlocalProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *git.Repository, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.repo = repo
+	d.data = data
 }
@@ -4,12 +4,26 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/go-git/go-git/v5"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestHeadDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -19,6 +33,12 @@ func TestHeadDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.gitlocal_head.test", "hash"),
 				),
 			},
+			{
+				Config: providerConfig + fmt.Sprintf(`data "gitlocal_head" "test" { repository_path = %q }`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_head.test", "hash", head.Hash().String()),
+				),
+			},
 		},
 	})
 }
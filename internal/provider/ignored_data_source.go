@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ignoredDataSource{}
+	_ datasource.DataSourceWithConfigure = &ignoredDataSource{}
+)
+
+// NewIgnoredDataSource is a helper function to simplify the provider implementation.
+func NewIgnoredDataSource() datasource.DataSource {
+	return &ignoredDataSource{}
+}
+
+// ignoredDataSource is the data source implementation.
+type ignoredDataSource struct {
+	data *gitlocalProviderData
+}
+
+// ignoredDataSourceModel maps the data source schema data.
+type ignoredDataSourceModel struct {
+	Files          []ignoredFileModel `tfsdk:"files"`
+	IgnoredPaths   []types.String     `tfsdk:"ignored_paths"`
+	Paths          []types.String     `tfsdk:"paths"`
+	RepositoryPath types.String       `tfsdk:"repository_path"`
+}
+
+// ignoredFileModel maps file schema data.
+type ignoredFileModel struct {
+	Ignored types.Bool   `tfsdk:"ignored"`
+	Path    types.String `tfsdk:"path"`
+}
+
+// Metadata returns the data source type name.
+func (d *ignoredDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ignored"
+}
+
+// Schema defines the schema for the data source.
+func (d *ignoredDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks whether git ignores paths of the worktree, as `git check-ignore --no-index` does, according to the `.gitignore` files of the worktree, the `info/exclude` file of the repository and `core.excludesFile`. " +
+			"Tracked files are reported as ignored when a pattern matches them.",
+		Attributes: map[string]schema.Attribute{
+			"files": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Whether each of `paths` is ignored, in the same order",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ignored": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether git ignores the path, or a directory containing it",
+						},
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Path, as set in `paths`",
+						},
+					},
+				},
+			},
+			"ignored_paths": schema.ListAttribute{
+				Computed:    true,
+				Description: "Paths of `paths` git ignores, in the same order",
+				ElementType: types.StringType,
+			},
+			"paths": schema.ListAttribute{
+				Description: "Paths to check, relative to the root of the worktree. The paths do not need to exist. Paths ending with `/`, or naming an existing directory, are matched as directories",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"repository_path": RepositoryPathAttribute(),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ignoredDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ignoredDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	worktree, diags := repositoryWorktree(repo, "gitlocal_ignored")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matcher, err := worktreeIgnoreMatcher(repo, worktree)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Ignore Patterns",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Files = []ignoredFileModel{}
+	state.IgnoredPaths = []types.String{}
+
+	for _, p := range state.Paths {
+		ignored := matchIgnored(matcher, p.ValueString(), worktreeIsDir(worktree, p.ValueString()))
+
+		state.Files = append(state.Files, ignoredFileModel{
+			Ignored: types.BoolValue(ignored),
+			Path:    p,
+		})
+
+		if ignored {
+			state.IgnoredPaths = append(state.IgnoredPaths, p)
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ignoredDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
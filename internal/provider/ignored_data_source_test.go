@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccIgnoreFixture returns a repository with nested .gitignore files, an
+// info/exclude file and a core.excludesFile in an isolated home directory.
+func testAccIgnoreFixture(t *testing.T) string {
+	t.Helper()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	fixture := testAccRepositoryFixture(t, "first")
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitFile(t, worktree, ".gitignore", "*.secret\n!keep.secret\nbuild/\n")
+	testAccCommitFile(t, worktree, "config/.gitignore", "*.tfvars\n!example.tfvars\n")
+
+	files := map[string]string{
+		filepath.Join(fixture, ".git", "info", "exclude"):      "local-only.txt\n",
+		filepath.Join(home, ".config", "git", "ignore"):        "*.swp\n",
+		filepath.Join(fixture, "generated", "credentials.txt"): "",
+	}
+
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return fixture
+}
+
+func TestIgnoredDataSource(t *testing.T) {
+	fixture := testAccIgnoreFixture(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_ignored" "test" {
+  paths = [
+    "api.secret",
+    "keep.secret",
+    "build/main.js",
+    "config/prod.tfvars",
+    "config/example.tfvars",
+    "local-only.txt",
+    "main.tf.swp",
+    "README.md",
+  ]
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_ignored.test", "files.#", "8"),
+					resource.TestCheckResourceAttr("data.gitlocal_ignored.test", "files.0.path", "api.secret"),
+					resource.TestCheckResourceAttr("data.gitlocal_ignored.test", "files.0.ignored", "true"),
+					resource.TestCheckResourceAttr("data.gitlocal_ignored.test", "files.1.ignored", "false"),
+					resource.TestCheckResourceAttr("data.gitlocal_ignored.test", "files.7.ignored", "false"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_ignored.test", "ignored_paths.#", "5"),
+					resource.TestCheckResourceAttr("data.gitlocal_ignored.test", "ignored_paths.1", "build/main.js"),
+					resource.TestCheckResourceAttr("data.gitlocal_ignored.test", "ignored_paths.2", "config/prod.tfvars"),
+					resource.TestCheckResourceAttr("data.gitlocal_ignored.test", "ignored_paths.3", "local-only.txt"),
+					resource.TestCheckResourceAttr("data.gitlocal_ignored.test", "ignored_paths.4", "main.tf.swp"),
+				),
+			},
+		},
+	})
+}
+
+func TestMatchIgnored(t *testing.T) {
+	var patterns []gitignore.Pattern
+	for _, line := range []string{"*.secret", "!keep.secret", "build/", "/dist", "logs"} {
+		patterns = append(patterns, gitignore.ParsePattern(line, nil))
+	}
+
+	matcher := gitignore.NewMatcher(patterns)
+
+	cases := []struct {
+		name  string
+		isDir bool
+		want  bool
+	}{
+		{"api.secret", false, true},
+		{"nested/api.secret", false, true},
+		{"keep.secret", false, false},
+		{"build", false, false},
+		{"build", true, true},
+		{"build/", false, true},
+		{"src/build/main.js", false, true},
+		{"dist/main.js", false, true},
+		{"src/dist/main.js", false, false},
+		{"logs/today.log", false, true},
+		{"README.md", false, false},
+	}
+
+	for _, c := range cases {
+		if got := matchIgnored(matcher, c.name, c.isDir); got != c.want {
+			t.Errorf("matchIgnored(%q, %t) = %t, want %t", c.name, c.isDir, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &isIgnoredFunction{}
+)
+
+// NewIsIgnoredFunction is a helper function to simplify the provider implementation.
+func NewIsIgnoredFunction() function.Function {
+	return &isIgnoredFunction{}
+}
+
+// isIgnoredFunction is the function implementation.
+type isIgnoredFunction struct{}
+
+// Metadata returns the function name.
+func (f *isIgnoredFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_ignored"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *isIgnoredFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Checks whether git ignores a path",
+		Description: "Returns whether git ignores the path, as `git check-ignore --no-index` does, according to the `.gitignore` files of the worktree containing it, the `info/exclude` file of the repository and `core.excludesFile`. " +
+			"The repository is found from the directory of the path, which does not need to exist.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "path",
+				Description: "Path to check, absolute or relative to the working directory, such as `\"${path.module}/secrets.auto.tfvars\"`. Paths ending with `/`, or naming an existing directory, are matched as directories",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run checks whether the path is ignored.
+func (f *isIgnoredFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	repo, err := git.PlainOpenWithOptions(filepath.Dir(abs), &git.PlainOpenOptions{
+		DetectDotGit:          true,
+		EnableDotGitCommonDir: true,
+	})
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Unable to open the repository containing `"+name+"`: "+err.Error())
+		return
+	}
+
+	worktree, err := repo.Worktree()
+	if errors.Is(err, git.ErrIsBareRepository) {
+		resp.Error = function.NewArgumentFuncError(0, "The repository containing `"+name+"` is bare.")
+		return
+	}
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	rel, ok := worktreeRelativePath(worktree.Filesystem.Root(), abs)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, "`"+name+"` is outside the worktree `"+worktree.Filesystem.Root()+"` of the repository containing it.")
+		return
+	}
+
+	if strings.HasSuffix(name, "/") {
+		rel += "/"
+	}
+
+	matcher, err := worktreeIgnoreMatcher(repo, worktree)
+	if err != nil {
+		resp.Error = function.NewFuncError("Unable to read ignore patterns: " + err.Error())
+		return
+	}
+
+	ignored := matchIgnored(matcher, rel, worktreeIsDir(worktree, rel))
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ignored))
+}
+
+// worktreeRelativePath returns the slash separated path of abs relative to the
+// worktree at root, and false when abs is not inside it.
+func worktreeRelativePath(root string, abs string) (string, bool) {
+	rel, err := filepath.Rel(root, abs)
+	if err != nil {
+		return "", false
+	}
+
+	rel = filepath.ToSlash(rel)
+	if rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", false
+	}
+
+	return rel, true
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIsIgnoredFunction(t *testing.T) {
+	fixture := testAccIgnoreFixture(t)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+output "secret" {
+  value = provider::gitlocal::is_ignored(%q)
+}
+
+output "example" {
+  value = provider::gitlocal::is_ignored(%q)
+}
+
+output "generated" {
+  value = provider::gitlocal::is_ignored(%q)
+}
+`,
+					filepath.Join(fixture, "config", "prod.tfvars"),
+					filepath.Join(fixture, "config", "example.tfvars"),
+					filepath.Join(fixture, "generated", "credentials.txt"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("secret", "true"),
+					resource.TestCheckOutput("example", "false"),
+					resource.TestCheckOutput("generated", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+output "test" {
+  value = provider::gitlocal::is_ignored(%q)
+}
+`, filepath.Join(t.TempDir(), "secrets.auto.tfvars")),
+				ExpectError: regexp.MustCompile(`Unable to open the repository`),
+			},
+		},
+	})
+}
+
+func TestWorktreeRelativePath(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "repo")
+
+	for _, test := range []struct {
+		abs    string
+		rel    string
+		inside bool
+	}{
+		{root, ".", true},
+		{filepath.Join(root, "config", "prod.tfvars"), "config/prod.tfvars", true},
+		{filepath.Join(root, "..tfvars"), "..tfvars", true},
+		{filepath.Dir(root), "", false},
+		{filepath.Join(filepath.Dir(root), "other", "prod.tfvars"), "", false},
+		{root + "-other", "", false},
+	} {
+		if rel, inside := worktreeRelativePath(root, test.abs); rel != test.rel || inside != test.inside {
+			t.Errorf("worktreeRelativePath(%q, %q) = %q, %t, want %q, %t", root, test.abs, rel, inside, test.rel, test.inside)
+		}
+	}
+}
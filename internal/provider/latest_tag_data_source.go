@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultTagPrefix is the prefix of version tags when none is configured.
+const defaultTagPrefix = "v"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &latestTagDataSource{}
+	_ datasource.DataSourceWithConfigure = &latestTagDataSource{}
+)
+
+// NewLatestTagDataSource is a helper function to simplify the provider implementation.
+func NewLatestTagDataSource() datasource.DataSource {
+	return &latestTagDataSource{}
+}
+
+// latestTagDataSource is the data source implementation.
+type latestTagDataSource struct {
+	data *gitlocalProviderData
+}
+
+// latestTagDataSourceModel maps the data source schema data.
+type latestTagDataSourceModel struct {
+	Commit            types.String `tfsdk:"commit"`
+	IncludePrerelease types.Bool   `tfsdk:"include_prerelease"`
+	Major             types.Int64  `tfsdk:"major"`
+	Metadata          types.String `tfsdk:"metadata"`
+	Minor             types.Int64  `tfsdk:"minor"`
+	Name              types.String `tfsdk:"name"`
+	Patch             types.Int64  `tfsdk:"patch"`
+	Prefix            types.String `tfsdk:"prefix"`
+	Prerelease        types.String `tfsdk:"prerelease"`
+	ReachableFrom     types.String `tfsdk:"reachable_from"`
+	RepositoryPath    types.String `tfsdk:"repository_path"`
+	Version           types.String `tfsdk:"version"`
+}
+
+// Metadata returns the data source type name.
+func (d *latestTagDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_latest_tag"
+}
+
+// Schema defines the schema for the data source.
+func (d *latestTagDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Finds the tag with the highest [semantic version](https://semver.org) among the tags made of a prefix followed by a version, such as `v1.2.3` or `myservice/v1.2.3`.",
+		Attributes: map[string]schema.Attribute{
+			"commit": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the commit the tag points to",
+			},
+			"include_prerelease": schema.BoolAttribute{
+				Description: "Whether to consider pre-release versions such as `1.2.3-rc.1`. Defaults to `false`",
+				Optional:    true,
+			},
+			"major": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Major component of the version",
+			},
+			"metadata": schema.StringAttribute{
+				Computed:    true,
+				Description: "Build metadata of the version, after the `+`. Null when the version has none",
+			},
+			"minor": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Minor component of the version",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the tag, including the prefix",
+			},
+			"patch": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Patch component of the version",
+			},
+			"prefix": schema.StringAttribute{
+				Description: "Prefix of the tag names before the version, such as `myservice/v`. Defaults to `v`",
+				Optional:    true,
+			},
+			"prerelease": schema.StringAttribute{
+				Computed:    true,
+				Description: "Pre-release of the version, after the `-`. Null when the version is not a pre-release",
+			},
+			"reachable_from": schema.StringAttribute{
+				Description: "Only consider tags of commits reachable from this revision, such as a commit hash, branch or `HEAD`. Defaults to every tag",
+				Optional:    true,
+			},
+			"repository_path": RepositoryPathAttribute(),
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the tag, without the prefix",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *latestTagDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state latestTagDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var reachableFrom *object.Commit
+	if !state.ReachableFrom.IsNull() {
+		reachableFrom, diags = resolveCommitAttribute(repo, path.Root("reachable_from"), state.ReachableFrom)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	prefix := defaultTagPrefix
+	if !state.Prefix.IsNull() {
+		prefix = state.Prefix.ValueString()
+	}
+
+	tags, err := listSemverTags(repo, prefix, state.IncludePrerelease.ValueBool(), reachableFrom)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Tags",
+			err.Error(),
+		)
+		return
+	}
+
+	if len(tags) == 0 {
+		resp.Diagnostics.AddError(
+			"No Tag Found",
+			"No tag is made of the prefix `"+prefix+"` followed by a semantic version.",
+		)
+		return
+	}
+
+	latest := tags[0]
+	segments := latest.version.Segments64()
+
+	state.Commit = types.StringValue(latest.commit.String())
+	state.Major = types.Int64Value(segments[0])
+	state.Metadata = types.StringNull()
+	state.Minor = types.Int64Value(segments[1])
+	state.Name = types.StringValue(latest.name)
+	state.Patch = types.Int64Value(segments[2])
+	state.Prerelease = types.StringNull()
+	state.Version = types.StringValue(latest.version.Original())
+
+	if metadata := latest.version.Metadata(); metadata != "" {
+		state.Metadata = types.StringValue(metadata)
+	}
+
+	if prerelease := latest.version.Prerelease(); prerelease != "" {
+		state.Prerelease = types.StringValue(prerelease)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *latestTagDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestLatestTagDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first", "second", "third", "fourth")
+	commits := testAccCommitHashes(t, fixture)
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, commit := range map[string]string{
+		"v1.9.0":          commits[0],
+		"v1.10.0":         commits[1],
+		"v2.0.0-rc.1":     commits[2],
+		"v1.11.0":         commits[3],
+		"v1.12":           commits[3],
+		"vnext":           commits[3],
+		"api/v0.1.0":      commits[0],
+		"api/v0.2.0+ci.5": commits[2],
+	} {
+		if _, err := repo.CreateTag(name, plumbing.NewHash(commit), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_latest_tag" "default" { }
+
+data "gitlocal_latest_tag" "prerelease" {
+  include_prerelease = true
+}
+
+data "gitlocal_latest_tag" "reachable" {
+  reachable_from = "HEAD~2"
+}
+
+data "gitlocal_latest_tag" "api" {
+  prefix = "api/v"
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.default", "name", "v1.11.0"),
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.default", "version", "1.11.0"),
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.default", "major", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.default", "minor", "11"),
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.default", "patch", "0"),
+					resource.TestCheckNoResourceAttr("data.gitlocal_latest_tag.default", "prerelease"),
+					resource.TestCheckNoResourceAttr("data.gitlocal_latest_tag.default", "metadata"),
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.default", "commit", commits[3]),
+
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.prerelease", "name", "v2.0.0-rc.1"),
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.prerelease", "prerelease", "rc.1"),
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.prerelease", "commit", commits[2]),
+
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.reachable", "name", "v1.10.0"),
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.reachable", "commit", commits[1]),
+
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.api", "name", "api/v0.2.0+ci.5"),
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.api", "version", "0.2.0+ci.5"),
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.api", "metadata", "ci.5"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_latest_tag" "missing" {
+  prefix = "web/v"
+}
+`, fixture),
+				ExpectError: regexp.MustCompile(`No Tag Found`),
+			},
+		},
+	})
+}
+
+func TestLatestTagDataSourceShallow(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first", "second", "third", "fourth")
+	commits := testAccCommitHashes(t, fixture)
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, commit := range map[string]string{
+		"v1.0.0": commits[0],
+		"v1.1.0": commits[2],
+	} {
+		if _, err := repo.CreateTag(name, plumbing.NewHash(commit), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testAccShallowFixture(t, fixture, plumbing.NewHash(commits[2]))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_latest_tag" "test" {
+  reachable_from = "HEAD"
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.test", "name", "v1.1.0"),
+					resource.TestCheckResourceAttr("data.gitlocal_latest_tag.test", "commit", commits[2]),
+				),
+			},
+		},
+	})
+}
+
+func TestParseSemverTag(t *testing.T) {
+	cases := []struct {
+		name   string
+		prefix string
+		want   string
+	}{
+		{"v1.2.3", "v", "1.2.3"},
+		{"v1.2.3-rc.1+build.5", "v", "1.2.3-rc.1+build.5"},
+		{"1.2.3", "", "1.2.3"},
+		{"api/v1.2.3", "api/v", "1.2.3"},
+		{"api/v1.2.3", "v", ""},
+		{"v1.2", "v", ""},
+		{"v01.2.3", "v", ""},
+		{"v1.2.3-", "v", ""},
+		{"vnext", "v", ""},
+	}
+
+	for _, c := range cases {
+		v, ok := parseSemverTag(c.name, c.prefix)
+
+		got := ""
+		if ok {
+			got = v.Original()
+		}
+
+		if got != c.want {
+			t.Errorf("parseSemverTag(%q, %q) = %q, want %q", c.name, c.prefix, got, c.want)
+		}
+	}
+}
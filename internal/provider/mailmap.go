@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// mailmapFileName is the name of the mailmap file at the root of a worktree.
+const mailmapFileName = ".mailmap"
+
+// MailmapAttribute returns the schema of the attribute toggling the mailmap,
+// shared by the data sources returning identities.
+func MailmapAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Whether to map names and emails with the `.mailmap` of the repository, and the files set by `mailmap.file` and `mailmap.blob`, as `git log --use-mailmap` does. Defaults to `true`",
+		Optional:    true,
+	}
+}
+
+// mailmapEntry is a line of a mailmap, replacing the identities with
+// commitEmail, and commitName when set, by the proper name and email when set.
+type mailmapEntry struct {
+	commitEmail string
+	commitName  string
+	properEmail string
+	properName  string
+}
+
+// mailmap maps the identities recorded in commits to canonical ones, as
+// described in gitmailmap(5).
+type mailmap []mailmapEntry
+
+// readMailmap returns the mailmap of repo, or an empty one when useMailmap is
+// false. Like git, it reads the .mailmap of the worktree unless the repository
+// is bare, then the blob set by mailmap.blob, defaulting to HEAD:.mailmap in
+// bare repositories, then the file set by mailmap.file. Missing files are
+// ignored.
+func readMailmap(repo *git.Repository, useMailmap types.Bool) (mailmap, error) {
+	if !useMailmap.IsNull() && !useMailmap.ValueBool() {
+		return nil, nil
+	}
+
+	configs, err := repositoryConfigs(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// mailmapOption returns the value of the mailmap option key, from the
+	// repository, user then system configuration.
+	mailmapOption := func(key string) string {
+		for _, cfg := range configs {
+			if value := cfg.Raw.Section("mailmap").Option(key); value != "" {
+				return value
+			}
+		}
+
+		return ""
+	}
+
+	var m mailmap
+
+	blob := mailmapOption("blob")
+
+	worktree, err := repo.Worktree()
+	switch {
+	case errors.Is(err, git.ErrIsBareRepository):
+		if blob == "" {
+			blob = "HEAD:" + mailmapFileName
+		}
+	case err != nil:
+		return nil, err
+	default:
+		if err := m.readFile(filepath.Join(worktree.Filesystem.Root(), mailmapFileName)); err != nil {
+			return nil, err
+		}
+	}
+
+	if blob != "" {
+		if err := m.readBlob(repo, blob); err != nil {
+			return nil, err
+		}
+	}
+
+	if file := mailmapOption("file"); file != "" {
+		file, err := expandHomePath(file)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := m.readFile(file); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// readFile adds the entries of the mailmap file at name, if it exists.
+func (m *mailmap) readFile(name string) error {
+	file, err := os.Open(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return m.read(file)
+}
+
+// readBlob adds the entries of the mailmap blob at rev, such as
+// `HEAD:.mailmap`, if it exists.
+func (m *mailmap) readBlob(repo *git.Repository, rev string) error {
+	commitRev, name, ok := strings.Cut(rev, ":")
+	if !ok {
+		return nil
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(commitRev))
+	if err != nil {
+		return nil
+	}
+
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil
+	}
+
+	file, err := commit.File(name)
+	if errors.Is(err, object.ErrFileNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	reader, err := file.Reader()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	return m.read(reader)
+}
+
+// read adds the entries read from r.
+func (m *mailmap) read(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		if entry, ok := parseMailmapLine(scanner.Text()); ok {
+			*m = append(*m, entry)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// parseMailmapLine parses a line of a mailmap, one of:
+//
+//	Proper Name <commit@email>
+//	<proper@email> <commit@email>
+//	Proper Name <proper@email> <commit@email>
+//	Proper Name <proper@email> Commit Name <commit@email>
+//
+// It returns false for comments and malformed lines.
+func parseMailmapLine(line string) (mailmapEntry, bool) {
+	if strings.HasPrefix(line, "#") {
+		return mailmapEntry{}, false
+	}
+
+	name1, email1, rest, ok := cutMailmapIdentity(line)
+	if !ok {
+		return mailmapEntry{}, false
+	}
+
+	name2, email2, _, ok := cutMailmapIdentity(rest)
+	if !ok {
+		return mailmapEntry{properName: name1, commitEmail: email1}, true
+	}
+
+	return mailmapEntry{
+		commitEmail: email2,
+		commitName:  name2,
+		properEmail: email1,
+		properName:  name1,
+	}, true
+}
+
+// cutMailmapIdentity returns the optional name and the email between angle
+// brackets at the start of s, followed by the rest of s.
+func cutMailmapIdentity(s string) (string, string, string, bool) {
+	name, rest, ok := strings.Cut(s, "<")
+	if !ok {
+		return "", "", "", false
+	}
+
+	email, rest, ok := strings.Cut(rest, ">")
+	if !ok {
+		return "", "", "", false
+	}
+
+	return strings.TrimSpace(name), strings.TrimSpace(email), rest, true
+}
+
+// resolve returns the canonical name and email of the identity. Entries
+// matching both the name and the email win over entries matching only the
+// email, and later entries override the proper name or email set by earlier
+// ones. Names and emails are compared case-insensitively.
+func (m mailmap) resolve(name string, email string) (string, string) {
+	var byEmail, byName mailmapEntry
+	var matchedEmail, matchedName bool
+
+	for _, entry := range m {
+		if !strings.EqualFold(entry.commitEmail, email) {
+			continue
+		}
+
+		switch {
+		case entry.commitName == "":
+			byEmail.merge(entry)
+			matchedEmail = true
+		case strings.EqualFold(entry.commitName, name):
+			byName.merge(entry)
+			matchedName = true
+		}
+	}
+
+	match := byEmail
+	if matchedName {
+		match = byName
+	} else if !matchedEmail {
+		return name, email
+	}
+
+	if match.properName != "" {
+		name = match.properName
+	}
+
+	if match.properEmail != "" {
+		email = match.properEmail
+	}
+
+	return name, email
+}
+
+// merge overrides the proper name and email of e with the ones set by entry.
+func (e *mailmapEntry) merge(entry mailmapEntry) {
+	if entry.properName != "" {
+		e.properName = entry.properName
+	}
+
+	if entry.properEmail != "" {
+		e.properEmail = entry.properEmail
+	}
+}
+
+// resolveSignature returns the canonical name and email of signature.
+func (m mailmap) resolveSignature(signature object.Signature) (string, string) {
+	return m.resolve(signature.Name, signature.Email)
+}
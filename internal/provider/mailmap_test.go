@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMailmapResolve(t *testing.T) {
+	var m mailmap
+
+	err := m.read(strings.NewReader(`# Comment
+Jane Doe <jane@old.example.com>
+<jane@example.com> <Jane@Old.Example.com>
+Jane Doe <jane@example.com> jane <jane@work.example.com>
+Build Bot <bot@example.com> CI <ci@example.com> # trailing comment
+Other Bot <other@example.com> <ci@example.com>
+malformed line
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name, email         string
+		wantName, wantEmail string
+	}{
+		{"jane", "jane@old.example.com", "Jane Doe", "jane@example.com"},
+		{"jane", "jane@work.example.com", "Jane Doe", "jane@example.com"},
+		{"Jane D.", "jane@work.example.com", "Jane D.", "jane@work.example.com"},
+		{"ci", "CI@example.com", "Build Bot", "bot@example.com"},
+		{"deploy", "ci@example.com", "Other Bot", "other@example.com"},
+		{"John", "john@example.com", "John", "john@example.com"},
+	}
+
+	for _, c := range cases {
+		name, email := m.resolve(c.name, c.email)
+		if name != c.wantName || email != c.wantEmail {
+			t.Errorf("resolve(%q, %q) = %q, %q, want %q, %q", c.name, c.email, name, email, c.wantName, c.wantEmail)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Defaults of the next version computation, matching the Angular convention
+// used by semantic-release.
+var (
+	defaultInitialVersion = "0.1.0"
+	defaultMinorTypes     = []string{"feat"}
+	defaultPatchTypes     = []string{"fix", "perf"}
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &nextVersionDataSource{}
+	_ datasource.DataSourceWithConfigure      = &nextVersionDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &nextVersionDataSource{}
+)
+
+// NewNextVersionDataSource is a helper function to simplify the provider implementation.
+func NewNextVersionDataSource() datasource.DataSource {
+	return &nextVersionDataSource{}
+}
+
+// nextVersionDataSource is the data source implementation.
+type nextVersionDataSource struct {
+	data *gitlocalProviderData
+}
+
+// nextVersionDataSourceModel maps the data source schema data.
+type nextVersionDataSourceModel struct {
+	Bump           types.String             `tfsdk:"bump"`
+	Commits        []nextVersionCommitModel `tfsdk:"commits"`
+	CurrentTag     types.String             `tfsdk:"current_tag"`
+	CurrentVersion types.String             `tfsdk:"current_version"`
+	InitialVersion types.String             `tfsdk:"initial_version"`
+	MinorTypes     []types.String           `tfsdk:"minor_types"`
+	NextTag        types.String             `tfsdk:"next_tag"`
+	NextVersion    types.String             `tfsdk:"next_version"`
+	PatchTypes     []types.String           `tfsdk:"patch_types"`
+	Prefix         types.String             `tfsdk:"prefix"`
+	RepositoryPath types.String             `tfsdk:"repository_path"`
+	Revision       types.String             `tfsdk:"revision"`
+}
+
+// nextVersionCommitModel maps contributing commit schema data.
+type nextVersionCommitModel struct {
+	Breaking    types.Bool   `tfsdk:"breaking"`
+	Bump        types.String `tfsdk:"bump"`
+	Description types.String `tfsdk:"description"`
+	Hash        types.String `tfsdk:"hash"`
+	Scope       types.String `tfsdk:"scope"`
+	Type        types.String `tfsdk:"type"`
+}
+
+// Metadata returns the data source type name.
+func (d *nextVersionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_next_version"
+}
+
+// Schema defines the schema for the data source.
+func (d *nextVersionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Computes the next semantic version from the [Conventional Commits](https://www.conventionalcommits.org) made since the latest version tag reachable from a revision. " +
+			"Breaking changes bump the major version, then the types of `minor_types` bump the minor version and the types of `patch_types` the patch version.",
+		Attributes: map[string]schema.Attribute{
+			"bump": schema.StringAttribute{
+				Computed:    true,
+				Description: "Component of the version bumped by the commits, one of `major`, `minor`, `patch` or `none`",
+			},
+			"commits": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Commits since the latest version tag that bump the version, most recent first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"breaking": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the commit is a breaking change, marked with `!` or a `BREAKING CHANGE` footer",
+						},
+						"bump": schema.StringAttribute{
+							Computed:    true,
+							Description: "Component of the version bumped by the commit, one of `major`, `minor` or `patch`",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the commit, after the type and scope",
+						},
+						"hash": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash of the commit",
+						},
+						"scope": schema.StringAttribute{
+							Computed:    true,
+							Description: "Scope of the commit. Null when the commit has none",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the commit in lower case, such as `feat` or `fix`",
+						},
+					},
+				},
+			},
+			"current_tag": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the latest version tag. Null when no tag was found",
+			},
+			"current_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the latest version tag, without the prefix. Null when no tag was found",
+			},
+			"initial_version": schema.StringAttribute{
+				Description: "Version to use as `next_version` when no version tag was found. Defaults to `" + defaultInitialVersion + "`",
+				Optional:    true,
+			},
+			"minor_types": schema.ListAttribute{
+				Description: "Commit types bumping the minor version. Defaults to `[\"feat\"]`",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"next_tag": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the tag for `next_version`, including the prefix",
+			},
+			"next_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Next version, without the prefix. Same as `current_version` when `bump` is `none`",
+			},
+			"patch_types": schema.ListAttribute{
+				Description: "Commit types bumping the patch version. Defaults to `[\"fix\", \"perf\"]`",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"prefix": schema.StringAttribute{
+				Description: "Prefix of the tag names before the version, such as `myservice/v`. Defaults to `v`",
+				Optional:    true,
+			},
+			"repository_path": RepositoryPathAttribute(),
+			"revision":        RevisionAttribute(),
+		},
+	}
+}
+
+// ValidateConfig validates the initial version.
+func (d *nextVersionDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config nextVersionDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.InitialVersion.IsNull() && !config.InitialVersion.IsUnknown() && !semverPattern.MatchString(config.InitialVersion.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("initial_version"),
+			"Invalid Initial Version",
+			"The initial version `"+config.InitialVersion.ValueString()+"` is not a semantic version such as `1.0.0`.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *nextVersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nextVersionDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commit, diags := resolveCommit(repo, state.Revision)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix := defaultTagPrefix
+	if !state.Prefix.IsNull() {
+		prefix = state.Prefix.ValueString()
+	}
+
+	minorTypes := stringValues(state.MinorTypes, defaultMinorTypes)
+	patchTypes := stringValues(state.PatchTypes, defaultPatchTypes)
+
+	tags, err := listSemverTags(repo, prefix, false, commit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Tags",
+			err.Error(),
+		)
+		return
+	}
+
+	since := plumbing.ZeroHash
+	if len(tags) > 0 {
+		since = tags[0].commit
+	}
+
+	commits, err := commitsSince(repo, commit, since, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Commits",
+			err.Error(),
+		)
+		return
+	}
+
+	bump := "none"
+	state.Commits = nil
+
+	for _, c := range commits {
+		conventional, ok := parseConventionalCommit(c.Message)
+		if !ok {
+			continue
+		}
+
+		var commitBump string
+
+		switch {
+		case conventional.breaking:
+			commitBump = "major"
+		case slices.Contains(minorTypes, conventional.typ):
+			commitBump = "minor"
+		case slices.Contains(patchTypes, conventional.typ):
+			commitBump = "patch"
+		default:
+			continue
+		}
+
+		if bumpRank(commitBump) > bumpRank(bump) {
+			bump = commitBump
+		}
+
+		model := nextVersionCommitModel{
+			Breaking:    types.BoolValue(conventional.breaking),
+			Bump:        types.StringValue(commitBump),
+			Description: types.StringValue(conventional.description),
+			Hash:        types.StringValue(c.Hash.String()),
+			Scope:       types.StringNull(),
+			Type:        types.StringValue(conventional.typ),
+		}
+
+		if conventional.scope != "" {
+			model.Scope = types.StringValue(conventional.scope)
+		}
+
+		state.Commits = append(state.Commits, model)
+	}
+
+	state.Bump = types.StringValue(bump)
+	state.CurrentTag = types.StringNull()
+	state.CurrentVersion = types.StringNull()
+
+	if len(tags) == 0 {
+		state.NextVersion = types.StringValue(defaultInitialVersion)
+		if !state.InitialVersion.IsNull() {
+			state.NextVersion = state.InitialVersion
+		}
+	} else {
+		state.CurrentTag = types.StringValue(tags[0].name)
+		state.CurrentVersion = types.StringValue(tags[0].version.Original())
+		state.NextVersion = types.StringValue(bumpVersion(tags[0].version, bump))
+	}
+
+	state.NextTag = types.StringValue(prefix + state.NextVersion.ValueString())
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nextVersionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// bumpRank orders the version components a commit can bump.
+func bumpRank(bump string) int {
+	return slices.Index([]string{"none", "patch", "minor", "major"}, bump)
+}
+
+// stringValues returns the values of list, or defaults when it is null.
+func stringValues(list []types.String, defaults []string) []string {
+	if list == nil {
+		return defaults
+	}
+
+	values := make([]string, 0, len(list))
+	for _, value := range list {
+		values = append(values, value.ValueString())
+	}
+
+	return values
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestNextVersionDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	tagged := testAccCommitHashes(t, fixture)[0]
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.CreateTag("v1.2.3", plumbing.NewHash(tagged), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	commits := testAccCommitMessages(t, fixture,
+		"fix(api): handle timeouts\n",
+		"docs: update the readme\n",
+		"feat: add search\n\nSearch across every index.\n",
+		"refactor!: drop the v1 API\n",
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_next_version" "major" { }
+
+data "gitlocal_next_version" "minor" {
+  revision = "HEAD~1"
+}
+
+data "gitlocal_next_version" "patch" {
+  revision = "HEAD~3"
+}
+
+data "gitlocal_next_version" "custom_types" {
+  revision    = "HEAD~2"
+  minor_types = []
+  patch_types = ["docs"]
+}
+
+data "gitlocal_next_version" "initial" {
+  prefix = "api/v"
+}
+
+data "gitlocal_next_version" "initial_version" {
+  prefix          = "api/v"
+  initial_version = "1.0.0"
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "current_tag", "v1.2.3"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "current_version", "1.2.3"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "bump", "major"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "next_version", "2.0.0"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "next_tag", "v2.0.0"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "commits.#", "3"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "commits.0.hash", commits[3].String()),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "commits.0.type", "refactor"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "commits.0.breaking", "true"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "commits.0.bump", "major"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "commits.1.description", "add search"),
+					resource.TestCheckNoResourceAttr("data.gitlocal_next_version.major", "commits.1.scope"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "commits.2.hash", commits[0].String()),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "commits.2.scope", "api"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.major", "commits.2.bump", "patch"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.minor", "bump", "minor"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.minor", "next_version", "1.3.0"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.patch", "bump", "patch"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.patch", "next_version", "1.2.4"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.custom_types", "commits.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.custom_types", "commits.0.hash", commits[1].String()),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.custom_types", "next_version", "1.2.4"),
+
+					resource.TestCheckNoResourceAttr("data.gitlocal_next_version.initial", "current_tag"),
+					resource.TestCheckNoResourceAttr("data.gitlocal_next_version.initial", "current_version"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.initial", "next_version", "0.1.0"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.initial", "next_tag", "api/v0.1.0"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.initial_version", "next_version", "1.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestNextVersionDataSourceShallow(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first", "second")
+	tagged := testAccCommitHashes(t, fixture)[1]
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.CreateTag("v1.2.3", plumbing.NewHash(tagged), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitMessages(t, fixture, "fix: handle timeouts\n", "feat: add search\n")
+	testAccShallowFixture(t, fixture, plumbing.NewHash(tagged))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_next_version" "tagged" { }
+
+data "gitlocal_next_version" "untagged" {
+  prefix = "api/v"
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.tagged", "current_tag", "v1.2.3"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.tagged", "commits.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.tagged", "next_version", "1.3.0"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.untagged", "commits.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_next_version.untagged", "next_version", "0.1.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseConventionalCommit(t *testing.T) {
+	cases := []struct {
+		message string
+		ok      bool
+		want    conventionalCommit
+	}{
+		{"feat: add search\n", true, conventionalCommit{description: "add search", typ: "feat"}},
+		{"Fix(API): handle timeouts", true, conventionalCommit{description: "handle timeouts", scope: "API", typ: "fix"}},
+		{"refactor(core)!: drop the v1 API\n", true, conventionalCommit{breaking: true, description: "drop the v1 API", scope: "core", typ: "refactor"}},
+		{"chore: bump dependencies\n\nBREAKING CHANGE: requires Go 1.23\n", true, conventionalCommit{breaking: true, description: "bump dependencies", typ: "chore"}},
+		{"chore: bump dependencies\n\nBREAKING-CHANGE: requires Go 1.23\n", true, conventionalCommit{breaking: true, description: "bump dependencies", typ: "chore"}},
+		{"docs: mention BREAKING CHANGE: in the guide\n", true, conventionalCommit{description: "mention BREAKING CHANGE: in the guide", typ: "docs"}},
+		{"Update README.md\n", false, conventionalCommit{}},
+		{"feat:missing space\n", false, conventionalCommit{}},
+		{"feat(: unbalanced\n", false, conventionalCommit{}},
+	}
+
+	for _, c := range cases {
+		got, ok := parseConventionalCommit(c.message)
+		if ok != c.ok || got != c.want {
+			t.Errorf("parseConventionalCommit(%q) = %+v, %t, want %+v, %t", c.message, got, ok, c.want, c.ok)
+		}
+	}
+}
@@ -7,8 +7,8 @@ import (
 	"context"
 	"os"
 
-	"github.com/go-git/go-git/v5"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -18,7 +18,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &gitlocalProvider{}
+	_ provider.Provider              = &gitlocalProvider{}
+	_ provider.ProviderWithFunctions = &gitlocalProvider{}
 )
 
 type gitlocalProvider struct {
@@ -29,7 +30,8 @@ type gitlocalProvider struct {
 }
 
 type gitlocalProviderModel struct {
-	Path types.String `tfsdk:"path"`
+	DetectDotGit types.Bool   `tfsdk:"detect_dot_git"`
+	Path         types.String `tfsdk:"path"`
 }
 
 // Metadata returns the provider type name.
@@ -41,9 +43,15 @@ func (p *gitlocalProvider) Metadata(_ context.Context, _ provider.MetadataReques
 func (p *gitlocalProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"detect_dot_git": schema.BoolAttribute{
+				Description: "Whether to search the parent directories of the path for the repository root, so the path can be any directory inside the repository. " +
+					"Defaults to `true` when the path falls back to the Terraform working directory, `false` otherwise",
+				Optional: true,
+			},
 			"path": schema.StringAttribute{
-				Description: "Path to the root of the local git repository",
-				Required:    true,
+				Description: "Path to the local git repository. Can also be set with the GIT_LOCAL_PATH environment variable. " +
+					"Defaults to the Terraform working directory",
+				Optional: true,
 			},
 		},
 	}
@@ -70,6 +78,15 @@ func (p *gitlocalProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if config.DetectDotGit.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("detect_dot_git"),
+			"Unknown detect_dot_git value",
+			"The provider cannot open the git repository as there is an unknown configuration value for detect_dot_git. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -83,25 +100,37 @@ func (p *gitlocalProvider) Configure(ctx context.Context, req provider.Configure
 		gitPath = config.Path.ValueString()
 	}
 
-	// If any of the expected configurations are missing, return
-	// errors with provider-specific guidance.
+	// Fall back to the Terraform working directory, searching its parents
+	// for the repository unless told otherwise.
+
+	detectDotGit := false
 
 	if gitPath == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("path"),
-			"Missing Git Local Path",
-			"The provider cannot open the git repository as there is a missing or empty value for the path. "+
-				"Set the path value in the configuration or use the GIT_LOCAL_PATH environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+		workingDir, err := os.Getwd()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("path"),
+				"Unable to Determine Working Directory",
+				"The provider cannot open the git repository as no path was set and the working directory could not be determined. "+
+					"Set the path value in the configuration or use the GIT_LOCAL_PATH environment variable.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+
+		gitPath = workingDir
+		detectDotGit = true
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	if !config.DetectDotGit.IsNull() {
+		detectDotGit = config.DetectDotGit.ValueBool()
 	}
 
-	repo, err := git.PlainOpen(gitPath)
-	if err != nil {
+	data := newGitlocalProviderData(gitPath, detectDotGit)
+
+	// Open the default repository right away so that an invalid path is
+	// reported against the provider configuration.
+	if _, err := data.Open(gitPath); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Open Git Repository",
 			"An unexpected error occurred when opening the git repository. "+
@@ -111,21 +140,48 @@ func (p *gitlocalProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	resp.DataSourceData = repo
-	resp.ResourceData = repo // todo: Do I need this?
+	resp.DataSourceData = data
+	resp.ResourceData = data
 }
 
 func (p *gitlocalProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAttributesDataSource,
+		NewBlameDataSource,
+		NewChangelogDataSource,
+		NewCodeownersDataSource,
 		NewCommitDataSource,
+		NewContributorsDataSource,
+		NewFileHistoryDataSource,
+		NewGrepDataSource,
 		NewHeadDataSource,
+		NewIgnoredDataSource,
+		NewLatestTagDataSource,
+		NewNextVersionDataSource,
+		NewReflogDataSource,
+		NewRefsDataSource,
 		NewRemoteDataSource,
 		NewRemotesDataSource,
+		NewRepositoryDataSource,
+		NewStashesDataSource,
+		NewSubmodulesDataSource,
+		NewTagDataSource,
+		NewTreeHashDataSource,
+		NewWorktreesDataSource,
 	}
 }
 
 func (p *gitlocalProvider) Resources(_ context.Context) []func() resource.Resource {
-	return nil
+	return []func() resource.Resource{
+		NewRepositoryResource,
+		NewSubmoduleResource,
+	}
+}
+
+func (p *gitlocalProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewIsIgnoredFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// gitlocalProviderData is handed to data sources and resources once the
+// provider is configured. It holds the default repository path, how
+// repositories are opened, a cache of the repositories opened so far, and the
+// locks resources hold while changing a repository.
+type gitlocalProviderData struct {
+	defaultPath  string
+	detectDotGit bool
+
+	mu           sync.Mutex
+	repositories map[string]*git.Repository
+	locks        map[string]*sync.Mutex
+}
+
+func newGitlocalProviderData(defaultPath string, detectDotGit bool) *gitlocalProviderData {
+	return &gitlocalProviderData{
+		defaultPath:  defaultPath,
+		detectDotGit: detectDotGit,
+		repositories: map[string]*git.Repository{},
+		locks:        map[string]*sync.Mutex{},
+	}
+}
+
+// Open returns the repository at the given path, opening it on first use.
+func (d *gitlocalProviderData) Open(repoPath string) (*git.Repository, error) {
+	key, err := filepath.Abs(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if repo, ok := d.repositories[key]; ok {
+		return repo, nil
+	}
+
+	// Linked worktrees keep their references in the common directory of the
+	// main repository, so it must be enabled for them to resolve.
+	repo, err := git.PlainOpenWithOptions(repoPath, &git.PlainOpenOptions{
+		DetectDotGit:          d.detectDotGit,
+		EnableDotGitCommonDir: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.repositories[key] = repo
+
+	return repo, nil
+}
+
+// Lock locks repo against changes by other resources and returns the function
+// unlocking it. Terraform applies independent resources concurrently, so
+// resources must hold the lock while they read, modify and write back files
+// such as .gitmodules or the index. Linked worktrees share the lock of their
+// main repository, as they share its configuration.
+func (d *gitlocalProviderData) Lock(repo *git.Repository) (func(), error) {
+	gitDir, err := repositoryGitDir(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	commonDir, err := readCommonDir(gitDir)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	lock, ok := d.locks[commonDir]
+	if !ok {
+		lock = &sync.Mutex{}
+		d.locks[commonDir] = lock
+	}
+	d.mu.Unlock()
+
+	lock.Lock()
+
+	return lock.Unlock, nil
+}
+
+// Repository returns the repository a data source should read from: the one
+// at repositoryPath when set, or the provider's repository otherwise.
+func (d *gitlocalProviderData) Repository(repositoryPath types.String) (*git.Repository, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	repoPath := d.defaultPath
+	if !repositoryPath.IsNull() {
+		repoPath = repositoryPath.ValueString()
+	}
+
+	repo, err := d.Open(repoPath)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("repository_path"),
+			"Unable to Open Git Repository `"+repoPath+"`",
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	return repo, diags
+}
+
+// RepositoryPathAttribute is the schema of the repository_path attribute
+// shared by every data source.
+func RepositoryPathAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Path to the local git repository to read from. Defaults to the provider `path`",
+		Optional:    true,
+	}
+}
+
+// RevisionAttribute is the schema of the revision attribute shared by the data
+// sources reading the repository at a given commit.
+func RevisionAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Revision to read, such as a commit hash, branch, tag or `HEAD~2`. Defaults to `HEAD`",
+		Optional:    true,
+	}
+}
+
+// resolveCommit returns the commit a revision attribute resolves to, HEAD when
+// it is null.
+func resolveCommit(repo *git.Repository, revision types.String) (*object.Commit, diag.Diagnostics) {
+	return resolveCommitAttribute(repo, path.Root("revision"), revision)
+}
+
+// resolveCommitAttribute returns the commit the revision attribute at attr
+// resolves to, HEAD when it is null.
+func resolveCommitAttribute(repo *git.Repository, attr path.Path, revision types.String) (*object.Commit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rev := plumbing.Revision(plumbing.HEAD)
+	if !revision.IsNull() {
+		rev = plumbing.Revision(revision.ValueString())
+	}
+
+	hash, err := repo.ResolveRevision(rev)
+	if err != nil {
+		diags.AddAttributeError(
+			attr,
+			"Unable to Resolve Revision `"+string(rev)+"`",
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		diags.AddAttributeError(
+			attr,
+			"Unable to Read Commit `"+hash.String()+"`",
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	return commit, diags
+}
+
+// repositoryGitDir returns the path to the git directory of a repository.
+func repositoryGitDir(repo *git.Repository) (string, error) {
+	storage, ok := repo.Storer.(*filesystem.Storage)
+	if !ok {
+		return "", fmt.Errorf("expected *filesystem.Storage, got: %T", repo.Storer)
+	}
+
+	return storage.Filesystem().Root(), nil
+}
+
+// readCommonDir resolves the commondir file of a git directory, which linked
+// worktrees use to point at the main repository.
+func readCommonDir(gitDir string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(gitDir, "commondir"))
+	if errors.Is(err, os.ErrNotExist) {
+		return gitDir, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	commonDir := strings.TrimSpace(string(content))
+	if !filepath.IsAbs(commonDir) {
+		commonDir = filepath.Join(gitDir, commonDir)
+	}
+
+	return filepath.Clean(commonDir), nil
+}
+
+// repositoryConfigs returns the repository, user then system configuration of
+// repo, in decreasing precedence.
+func repositoryConfigs(repo *git.Repository) ([]*config.Config, error) {
+	local, err := repo.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	configs := []*config.Config{local}
+
+	for _, scope := range []config.Scope{config.GlobalScope, config.SystemScope} {
+		cfg, err := config.LoadConfig(scope)
+		if err != nil {
+			return nil, err
+		}
+
+		configs = append(configs, cfg)
+	}
+
+	return configs, nil
+}
+
+// expandHomePath expands a leading `~/` of the path name set in a git
+// configuration to the home directory of the user.
+func expandHomePath(name string) (string, error) {
+	rest, ok := strings.CutPrefix(name, "~/")
+	if !ok {
+		return name, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, rest), nil
+}
+
+// repositoryWorktree returns the worktree of repo, with a diagnostic naming the
+// data source or resource when the repository is bare.
+func repositoryWorktree(repo *git.Repository, typeName string) (*git.Worktree, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	worktree, err := repo.Worktree()
+	if errors.Is(err, git.ErrIsBareRepository) {
+		diags.AddError(
+			"Repository Has No Worktree",
+			"`"+typeName+"` reads the worktree of the repository, but the repository is bare. "+
+				"Set `repository_path` or the provider `path` to a repository with a worktree.",
+		)
+		return nil, diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Git Worktree",
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	return worktree, diags
+}
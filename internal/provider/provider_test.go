@@ -4,8 +4,31 @@
 package provider
 
 import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"golang.org/x/crypto/ssh"
 )
 
 const (
@@ -24,4 +47,658 @@ var (
 	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"gitlocal": providerserver.NewProtocol6WithError(New("test")()),
 	}
+
+	// testAccSignature is the author and committer of every fixture commit.
+	testAccSignature = object.Signature{
+		Name:  "Fixture Author",
+		Email: "fixture@example.com",
+		When:  time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
 )
+
+// testAccRepositoryFixture creates a repository in a temporary directory with
+// one commit per given file content, and returns its path.
+func testAccRepositoryFixture(t *testing.T, contents ...string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, content := range contents {
+		testAccCommitFile(t, worktree, "README.md", content)
+	}
+
+	return dir
+}
+
+// testAccMailmapFixture creates a repository whose README.md was written by
+// the same person under two identities, merged by its .mailmap, and returns
+// its path.
+func testAccMailmapFixture(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := []struct {
+		name, content string
+		author        object.Signature
+	}{
+		{"README.md", "first\n", object.Signature{Name: "Jane Doe", Email: "jane@old.example.com", When: testAccSignature.When}},
+		{"README.md", "first\nsecond\n", object.Signature{Name: "jane", Email: "jane@work.example.com", When: testAccSignature.When.Add(time.Hour)}},
+		{".mailmap", "Jane Doe <jane@example.com> <jane@old.example.com>\nJane Doe <jane@example.com> jane <jane@work.example.com>\n", testAccSignature},
+	}
+
+	for _, file := range files {
+		if err := os.WriteFile(filepath.Join(dir, file.name), []byte(file.content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := worktree.Add(file.name); err != nil {
+			t.Fatal(err)
+		}
+
+		author := file.author
+		if _, err := worktree.Commit("Update "+file.name+"\n", &git.CommitOptions{Author: &author, Committer: &author}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+// testAccCommitFile writes content to name in the worktree and commits it.
+func testAccCommitFile(t *testing.T, worktree *git.Worktree, name string, content string) {
+	t.Helper()
+
+	fullPath := filepath.Join(worktree.Filesystem.Root(), name)
+
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(fullPath, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := worktree.Add(name); err != nil {
+		t.Fatal(err)
+	}
+
+	signature := testAccSignature
+	if _, err := worktree.Commit("Update "+name+"\n", &git.CommitOptions{Author: &signature, Committer: &signature}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testAccCommitMessages creates one empty commit per given message in the
+// repository at repoPath, and returns their hashes in order.
+func testAccCommitMessages(t *testing.T, repoPath string, messages ...string) []plumbing.Hash {
+	t.Helper()
+
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var hashes []plumbing.Hash
+
+	for _, message := range messages {
+		signature := testAccSignature
+
+		hash, err := worktree.Commit(message, &git.CommitOptions{
+			AllowEmptyCommits: true,
+			Author:            &signature,
+			Committer:         &signature,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		hashes = append(hashes, hash)
+	}
+
+	return hashes
+}
+
+// testAccShallowFixture cuts the history of the repository at repoPath at
+// boundary, as `git clone --depth` does: boundary is listed in .git/shallow and
+// the commits before it are missing from the object database.
+func testAccShallowFixture(t *testing.T, repoPath string, boundary plumbing.Hash) {
+	t.Helper()
+
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commit, err := repo.CommitObject(boundary)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var missing []plumbing.Hash
+
+	for _, parent := range commit.ParentHashes {
+		commits, err := repo.Log(&git.LogOptions{From: parent})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = commits.ForEach(func(c *object.Commit) error {
+			missing = append(missing, c.Hash)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, hash := range missing {
+		name := hash.String()
+		if err := os.Remove(filepath.Join(repoPath, ".git", "objects", name[:2], name[2:])); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(repoPath, ".git", "shallow"), []byte(boundary.String()+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProviderDetectDotGit(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+
+	subdirectory := filepath.Join(fixture, "modules", "app")
+	if err := os.MkdirAll(subdirectory, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path           = %q
+  detect_dot_git = true
+}
+
+data "gitlocal_head" "test" { }
+`, subdirectory),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.gitlocal_head.test", "hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderPathFromEnvironment(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	t.Setenv("GIT_LOCAL_PATH", fixture)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "gitlocal" { }
+
+data "gitlocal_repository" "test" { }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_repository.test", "worktree_root", fixture),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderPathFromWorkingDirectory(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	t.Setenv("GIT_LOCAL_PATH", "")
+
+	subdirectory := filepath.Join(fixture, "modules", "app")
+	if err := os.MkdirAll(subdirectory, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(subdirectory); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := os.Chdir(workingDir); err != nil {
+			t.Error(err)
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "gitlocal" { }
+
+data "gitlocal_repository" "test" { }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_repository.test", "worktree_root", fixture),
+				),
+			},
+		},
+	})
+}
+
+func TestRepositoryWorktree(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+
+	bare := filepath.Join(t.TempDir(), "bare.git")
+	if _, err := git.PlainClone(bare, true, &git.CloneOptions{URL: fixture}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		path string
+		bare bool
+	}{
+		{fixture, false},
+		{bare, true},
+	} {
+		repo, err := git.PlainOpen(test.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		worktree, diags := repositoryWorktree(repo, "gitlocal_test")
+
+		if test.bare {
+			if !diags.HasError() || diags[0].Summary() != "Repository Has No Worktree" {
+				t.Errorf("repositoryWorktree(%q) = %v, want a Repository Has No Worktree error", test.path, diags)
+			}
+			continue
+		}
+
+		if diags.HasError() || worktree == nil {
+			t.Errorf("repositoryWorktree(%q) = %v, %v, want the worktree", test.path, worktree, diags)
+		}
+	}
+}
+
+// testAccLinkedWorktreeFixture adds a linked worktree to the repository at
+// mainPath, laid out as `git worktree add` would with a new branch at HEAD,
+// and returns its path. Files are not checked out.
+func testAccLinkedWorktreeFixture(t *testing.T, mainPath string, branch string) string {
+	t.Helper()
+
+	repo, err := git.PlainOpen(mainPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	branchName := plumbing.NewBranchReferenceName(branch)
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(branchName, head.Hash())); err != nil {
+		t.Fatal(err)
+	}
+
+	worktreePath := t.TempDir()
+	gitDir := filepath.Join(mainPath, ".git", "worktrees", branch)
+
+	if err := os.MkdirAll(gitDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{
+		filepath.Join(gitDir, "HEAD"):       "ref: " + branchName.String() + "\n",
+		filepath.Join(gitDir, "commondir"):  "../..\n",
+		filepath.Join(gitDir, "gitdir"):     filepath.Join(worktreePath, ".git") + "\n",
+		filepath.Join(worktreePath, ".git"): "gitdir: " + gitDir + "\n",
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return worktreePath
+}
+
+// testAccSubmoduleFixture registers the repository at url as a submodule of
+// the repository at superPath, recording its HEAD as the gitlink and
+// committing the change. The submodule is checked out when checkout is set,
+// and is left as an empty directory otherwise, as after a non-recursive clone.
+func testAccSubmoduleFixture(t *testing.T, superPath string, name string, url string, checkout bool) plumbing.Hash {
+	t.Helper()
+
+	submodule, err := git.PlainOpen(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	head, err := submodule.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo, err := git.PlainOpen(superPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	modules, err := readGitmodules(worktree)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	modules.Submodules[name] = &config.Submodule{Name: name, Path: name, URL: url}
+
+	content, err := modules.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(superPath, ".gitmodules"), content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := worktree.Add(".gitmodules"); err != nil {
+		t.Fatal(err)
+	}
+
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := idx.Add(name)
+	entry.Hash = head.Hash()
+	entry.Mode = filemode.Submodule
+
+	if err := repo.Storer.SetIndex(idx); err != nil {
+		t.Fatal(err)
+	}
+
+	signature := testAccSignature
+	if _, err := worktree.Commit("Add submodule "+name+"\n", &git.CommitOptions{Author: &signature, Committer: &signature}); err != nil {
+		t.Fatal(err)
+	}
+
+	submodulePath := filepath.Join(superPath, name)
+
+	if checkout {
+		if _, err := git.PlainClone(submodulePath, false, &git.CloneOptions{URL: url}); err != nil {
+			t.Fatal(err)
+		}
+	} else if err := os.MkdirAll(submodulePath, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	return head.Hash()
+}
+
+// testAccCommitHashes returns the hashes of the commits reachable from HEAD of
+// the repository at repoPath, oldest first.
+func testAccCommitHashes(t *testing.T, repoPath string) []string {
+	t.Helper()
+
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commits, err := repo.Log(&git.LogOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var hashes []string
+	err = commits.ForEach(func(commit *object.Commit) error {
+		hashes = append([]string{commit.Hash.String()}, hashes...)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return hashes
+}
+
+// testAccAppendReflog appends an entry to the reflog of the named reference
+// in the git directory at gitDir, as go-git does not maintain reflogs.
+func testAccAppendReflog(t *testing.T, gitDir string, name plumbing.ReferenceName, oldHash plumbing.Hash, newHash plumbing.Hash, message string) {
+	t.Helper()
+
+	logPath := filepath.Join(gitDir, "logs", name.String())
+
+	if err := os.MkdirAll(filepath.Dir(logPath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.OpenFile(logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var signature bytes.Buffer
+	if err := testAccSignature.Encode(&signature); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := fmt.Fprintf(file, "%s %s %s\t%s\n", oldHash, newHash, signature.String(), message); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testAccStashFixture records a stash entry on top of HEAD of the repository
+// at repoPath, with the stashed tree equal to the HEAD tree, and returns the
+// hash of the stash commit.
+func testAccStashFixture(t *testing.T, repoPath string, message string) plumbing.Hash {
+	t.Helper()
+
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	headCommit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stash := &object.Commit{
+		Author:       testAccSignature,
+		Committer:    testAccSignature,
+		Message:      message + "\n",
+		TreeHash:     headCommit.TreeHash,
+		ParentHashes: []plumbing.Hash{head.Hash()},
+	}
+
+	encoded := repo.Storer.NewEncodedObject()
+	if err := stash.Encode(encoded); err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err := repo.Storer.SetEncodedObject(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stashName := plumbing.ReferenceName("refs/stash")
+
+	previous := plumbing.ZeroHash
+	if ref, err := repo.Reference(stashName, false); err == nil {
+		previous = ref.Hash()
+	}
+
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(stashName, hash)); err != nil {
+		t.Fatal(err)
+	}
+
+	testAccAppendReflog(t, filepath.Join(repoPath, ".git"), stashName, previous, hash, message)
+
+	return hash
+}
+
+// testAccPGPEntity generates a PGP key for the given identity, and returns it
+// along with its ASCII armored public key.
+func testAccPGPEntity(t *testing.T, name string, email string) (*openpgp.Entity, string) {
+	t.Helper()
+
+	entity, err := openpgp.NewEntity(name, "", email, &packet.Config{Algorithm: packet.PubKeyAlgoEdDSA})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var public bytes.Buffer
+
+	writer, err := armor.Encode(&public, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := entity.Serialize(writer); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return entity, public.String()
+}
+
+// testAccSignedCommit commits a change to SIGNED.md in the repository at
+// repoPath, signed with either signKey or signer, and returns the hash of the
+// commit.
+func testAccSignedCommit(t *testing.T, repoPath string, signKey *openpgp.Entity, signer git.Signer) plumbing.Hash {
+	t.Helper()
+
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(repoPath, "SIGNED.md"), []byte(time.Now().String()), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := worktree.Add("SIGNED.md"); err != nil {
+		t.Fatal(err)
+	}
+
+	signature := testAccSignature
+	hash, err := worktree.Commit("Update SIGNED.md\n", &git.CommitOptions{Author: &signature, Committer: &signature, SignKey: signKey, Signer: signer})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return hash
+}
+
+// testAccSSHSigner signs git objects with an SSH key, producing the armored
+// signatures of `ssh-keygen -Y sign -n git`.
+type testAccSSHSigner struct {
+	signer ssh.Signer
+}
+
+// testAccNewSSHSigner generates an SSH key, and returns a signer using it along
+// with its public key in the authorized keys format.
+func testAccNewSSHSigner(t *testing.T) (testAccSSHSigner, string) {
+	t.Helper()
+
+	_, private, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := ssh.NewSignerFromKey(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return testAccSSHSigner{signer: signer}, strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey())))
+}
+
+func (s testAccSSHSigner) Sign(message io.Reader) ([]byte, error) {
+	content, err := io.ReadAll(message)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha512.Sum512(content)
+
+	var magic [6]byte
+	copy(magic[:], sshSignatureMagic)
+
+	signature, err := s.signer.Sign(rand.Reader, ssh.Marshal(sshSignedData{
+		Magic:         magic,
+		Namespace:     sshSignatureNamespace,
+		HashAlgorithm: "sha512",
+		Hash:          digest[:],
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	blob := ssh.Marshal(sshSignature{
+		Magic:         magic,
+		Version:       1,
+		PublicKey:     s.signer.PublicKey().Marshal(),
+		Namespace:     sshSignatureNamespace,
+		HashAlgorithm: "sha512",
+		Signature:     ssh.Marshal(signature),
+	})
+
+	return pem.EncodeToMemory(&pem.Block{Type: sshSignaturePEMType, Bytes: blob}), nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+// reflogEntry is a single update of a reference, as recorded in its reflog.
+type reflogEntry struct {
+	Committer object.Signature
+	Message   string
+	New       plumbing.Hash
+	Old       plumbing.Hash
+}
+
+// readReflog returns the reflog of the named reference, most recent entry
+// first. An empty reflog is returned when the reference has none.
+func readReflog(repo *git.Repository, name plumbing.ReferenceName) ([]reflogEntry, error) {
+	storage, ok := repo.Storer.(*filesystem.Storage)
+	if !ok {
+		return nil, fmt.Errorf("expected *filesystem.Storage, got: %T", repo.Storer)
+	}
+
+	// The storage filesystem maps logs/HEAD to the worktree git directory and
+	// every other reflog to the common directory.
+	file, err := storage.Filesystem().Open("logs/" + name.String())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []reflogEntry
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		entry, err := parseReflogLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reflog entry for %s: %w", name, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(entries)
+
+	return entries, nil
+}
+
+// parseReflogLine parses a reflog line of the form
+// `<old> <new> <name> <<email>> <timestamp> <timezone>\t<message>`.
+func parseReflogLine(line string) (reflogEntry, error) {
+	var entry reflogEntry
+
+	header, message, _ := strings.Cut(line, "\t")
+
+	fields := strings.SplitN(header, " ", 3)
+	if len(fields) != 3 || !plumbing.IsHash(fields[0]) || !plumbing.IsHash(fields[1]) {
+		return entry, fmt.Errorf("malformed line %q", line)
+	}
+
+	entry.Old = plumbing.NewHash(fields[0])
+	entry.New = plumbing.NewHash(fields[1])
+	entry.Committer.Decode([]byte(fields[2]))
+	entry.Message = message
+
+	return entry, nil
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &reflogDataSource{}
+	_ datasource.DataSourceWithConfigure      = &reflogDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &reflogDataSource{}
+)
+
+// NewReflogDataSource is a helper function to simplify the provider implementation.
+func NewReflogDataSource() datasource.DataSource {
+	return &reflogDataSource{}
+}
+
+// reflogDataSource is the data source implementation.
+type reflogDataSource struct {
+	data *gitlocalProviderData
+}
+
+// reflogDataSourceModel maps the data source schema data.
+type reflogDataSourceModel struct {
+	Entries        []reflogModel `tfsdk:"entries"`
+	MaxEntries     types.Int64   `tfsdk:"max_entries"`
+	Reference      types.String  `tfsdk:"reference"`
+	RepositoryPath types.String  `tfsdk:"repository_path"`
+}
+
+// reflogModel maps reflog entry schema data.
+type reflogModel struct {
+	CommitterEmail types.String `tfsdk:"committer_email"`
+	CommitterName  types.String `tfsdk:"committer_name"`
+	Date           types.String `tfsdk:"date"`
+	Message        types.String `tfsdk:"message"`
+	NewHash        types.String `tfsdk:"new_hash"`
+	OldHash        types.String `tfsdk:"old_hash"`
+}
+
+// Metadata returns the data source type name.
+func (d *reflogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reflog"
+}
+
+// Schema defines the schema for the data source.
+func (d *reflogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the updates of a reference recorded in its reflog.",
+		Attributes: map[string]schema.Attribute{
+			"entries": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of reflog entries, most recent first. Empty when the reference has no reflog",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"committer_email": schema.StringAttribute{
+							Computed:    true,
+							Description: "Email of the identity that updated the reference",
+						},
+						"committer_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the identity that updated the reference",
+						},
+						"date": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date of the update in RFC 3339",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Message describing the update",
+						},
+						"new_hash": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash the reference pointed to after the update",
+						},
+						"old_hash": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash the reference pointed to before the update. All zeros when the reference was created",
+						},
+					},
+				},
+			},
+			"max_entries": schema.Int64Attribute{
+				Description: "Maximum number of entries to return. Defaults to every entry",
+				Optional:    true,
+			},
+			"reference": schema.StringAttribute{
+				Description: "Reference to read the reflog of, either `HEAD`, a full reference name such as `refs/heads/main`, or a branch name. The reference must exist. Defaults to `HEAD`",
+				Optional:    true,
+			},
+			"repository_path": RepositoryPathAttribute(),
+		},
+	}
+}
+
+// ValidateConfig validates the entry limit.
+func (d *reflogDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config reflogDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.MaxEntries.IsNull() && !config.MaxEntries.IsUnknown() && config.MaxEntries.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_entries"),
+			"Invalid Maximum Entries",
+			"The maximum number of entries must be at least 1.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *reflogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state reflogDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plumbing.HEAD
+	if !state.Reference.IsNull() {
+		name = expandReferenceName(state.Reference.ValueString())
+	}
+
+	// A reference without a reflog has no entries, but a missing one is
+	// more likely a typo than an empty history.
+	_, err := repo.Reference(name, false)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("reference"),
+			"No Reference Found",
+			"The reference `"+name.String()+"` does not exist.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("reference"),
+			"Unable to Read Reference `"+name.String()+"`",
+			err.Error(),
+		)
+		return
+	}
+
+	entries, err := readReflog(repo, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Reflog `"+name.String()+"`",
+			err.Error(),
+		)
+		return
+	}
+
+	if !state.MaxEntries.IsNull() && int64(len(entries)) > state.MaxEntries.ValueInt64() {
+		entries = entries[:state.MaxEntries.ValueInt64()]
+	}
+
+	state.Entries = nil
+
+	for _, entry := range entries {
+		state.Entries = append(state.Entries, reflogModel{
+			CommitterEmail: types.StringValue(entry.Committer.Email),
+			CommitterName:  types.StringValue(entry.Committer.Name),
+			Date:           types.StringValue(entry.Committer.When.Format(time.RFC3339)),
+			Message:        types.StringValue(entry.Message),
+			NewHash:        types.StringValue(entry.New.String()),
+			OldHash:        types.StringValue(entry.Old.String()),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *reflogDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// expandReferenceName turns a branch name into a full reference name, leaving
+// HEAD and names under refs/ untouched.
+func expandReferenceName(name string) plumbing.ReferenceName {
+	if name == plumbing.HEAD.String() || strings.HasPrefix(name, "refs/") {
+		return plumbing.ReferenceName(name)
+	}
+
+	return plumbing.NewBranchReferenceName(name)
+}
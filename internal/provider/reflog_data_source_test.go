@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestReflogDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first", "second")
+	commits := testAccCommitHashes(t, fixture)
+	gitDir := filepath.Join(fixture, ".git")
+
+	first := plumbing.NewHash(commits[0])
+	second := plumbing.NewHash(commits[1])
+
+	testAccAppendReflog(t, gitDir, plumbing.HEAD, plumbing.ZeroHash, first, "commit (initial): first")
+	testAccAppendReflog(t, gitDir, plumbing.HEAD, first, second, "commit: second")
+	testAccAppendReflog(t, gitDir, plumbing.NewBranchReferenceName("master"), plumbing.ZeroHash, second, "branch: Created from HEAD")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_reflog" "head" { }
+
+data "gitlocal_reflog" "latest" {
+  max_entries = 1
+}
+
+data "gitlocal_reflog" "branch" {
+  reference = "master"
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.head", "entries.#", "2"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.head", "entries.0.old_hash", commits[0]),
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.head", "entries.0.new_hash", commits[1]),
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.head", "entries.0.message", "commit: second"),
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.head", "entries.0.committer_name", "Fixture Author"),
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.head", "entries.0.committer_email", "fixture@example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.head", "entries.0.date", "2025-01-02T03:04:05Z"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.head", "entries.1.old_hash", plumbing.ZeroHash.String()),
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.head", "entries.1.new_hash", commits[0]),
+
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.latest", "entries.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.latest", "entries.0.new_hash", commits[1]),
+
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.branch", "entries.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.branch", "entries.0.message", "branch: Created from HEAD"),
+				),
+			},
+		},
+	})
+}
+
+func TestReflogDataSourceMissingReference(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_reflog" "test" {
+  reference = "master"
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_reflog.test", "entries.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_reflog" "test" {
+  reference = "mastr"
+}
+`, fixture),
+				ExpectError: regexp.MustCompile(`No Reference Found`),
+			},
+		},
+	})
+}
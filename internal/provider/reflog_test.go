@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseReflogLine(t *testing.T) {
+	entry, err := parseReflogLine("0000000000000000000000000000000000000000 719e6a8aa65073d3e74d63006b6b9c99bf84c14d Jane Doe <jane@example.com> 1747227908 -0400\tcommit: Add head data source")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !entry.Old.IsZero() {
+		t.Errorf("unexpected old hash %s", entry.Old)
+	}
+
+	if entry.New.String() != "719e6a8aa65073d3e74d63006b6b9c99bf84c14d" {
+		t.Errorf("unexpected new hash %s", entry.New)
+	}
+
+	if entry.Committer.Name != "Jane Doe" || entry.Committer.Email != "jane@example.com" {
+		t.Errorf("unexpected committer %s", entry.Committer)
+	}
+
+	if entry.Committer.When.Format(time.RFC3339) != "2025-05-14T09:05:08-04:00" {
+		t.Errorf("unexpected date %s", entry.Committer.When.Format(time.RFC3339))
+	}
+
+	if entry.Message != "commit: Add head data source" {
+		t.Errorf("unexpected message %q", entry.Message)
+	}
+
+	if _, err := parseReflogLine("not a reflog line"); err == nil {
+		t.Error("expected an error for a malformed line")
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &refsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &refsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &refsDataSource{}
+)
+
+// NewRefsDataSource is a helper function to simplify the provider implementation.
+func NewRefsDataSource() datasource.DataSource {
+	return &refsDataSource{}
+}
+
+// refsDataSource is the data source implementation.
+type refsDataSource struct {
+	data *gitlocalProviderData
+}
+
+// refsDataSourceModel maps the data source schema data.
+type refsDataSourceModel struct {
+	Exclude        []types.String `tfsdk:"exclude"`
+	Include        []types.String `tfsdk:"include"`
+	References     []refsModel    `tfsdk:"references"`
+	RepositoryPath types.String   `tfsdk:"repository_path"`
+}
+
+// refsModel maps reference schema data.
+type refsModel struct {
+	Name   types.String `tfsdk:"name"`
+	Peeled types.String `tfsdk:"peeled"`
+	Target types.String `tfsdk:"target"`
+	Type   types.String `tfsdk:"type"`
+}
+
+// Metadata returns the data source type name.
+func (d *refsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_refs"
+}
+
+// Schema defines the schema for the data source.
+func (d *refsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the references of the repository across every namespace, such as `refs/pull/` or custom ones. " +
+			"Patterns are matched against the full reference name, one path segment at a time: `*` never matches a `/`, so `refs/pull/*` matches `refs/pull/1` but not `refs/pull/1/head`, while a `**` segment matches any number of segments.",
+		Attributes: map[string]schema.Attribute{
+			"exclude": schema.ListAttribute{
+				Description: "Glob patterns of the references to leave out, applied after `include`",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"include": schema.ListAttribute{
+				Description: "Glob patterns of the references to list, such as `refs/pull/*/head` or `refs/deploy/**`. Defaults to every reference",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"references": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of matching references, ordered by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Full name of the reference",
+						},
+						"peeled": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash of the object the reference ultimately points to, following symbolic references and annotated tags. Null when a symbolic reference points to a missing reference",
+						},
+						"target": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash the reference points to, or the name of the reference a symbolic reference points to",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the reference, either `hash` or `symbolic`",
+						},
+					},
+				},
+			},
+			"repository_path": RepositoryPathAttribute(),
+		},
+	}
+}
+
+// ValidateConfig validates the glob patterns.
+func (d *refsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config refsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attribute, patterns := range map[string][]types.String{"exclude": config.Exclude, "include": config.Include} {
+		for i, pattern := range patterns {
+			if pattern.IsNull() || pattern.IsUnknown() {
+				continue
+			}
+
+			if err := validateGlob(pattern.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute).AtListIndex(i),
+					"Invalid Reference Pattern",
+					"The pattern `"+pattern.ValueString()+"` is not a valid glob: "+err.Error(),
+				)
+			}
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *refsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state refsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refs, err := repo.References()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git References",
+			err.Error(),
+		)
+		return
+	}
+
+	var matched []*plumbing.Reference
+
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().String()
+
+		include := len(state.Include) == 0
+		for _, pattern := range state.Include {
+			if matchGlob(pattern.ValueString(), name) {
+				include = true
+				break
+			}
+		}
+
+		for _, pattern := range state.Exclude {
+			if matchGlob(pattern.ValueString(), name) {
+				include = false
+				break
+			}
+		}
+
+		if include {
+			matched = append(matched, ref)
+		}
+
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git References",
+			err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].Name() < matched[j].Name()
+	})
+
+	state.References = nil
+
+	for _, ref := range matched {
+		reference := refsModel{
+			Name:   types.StringValue(ref.Name().String()),
+			Peeled: types.StringNull(),
+		}
+
+		if ref.Type() == plumbing.SymbolicReference {
+			reference.Target = types.StringValue(ref.Target().String())
+			reference.Type = types.StringValue("symbolic")
+		} else {
+			reference.Target = types.StringValue(ref.Hash().String())
+			reference.Type = types.StringValue("hash")
+		}
+
+		peeled, err := peelReference(repo, ref)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Peel Git Reference `"+ref.Name().String()+"`",
+				err.Error(),
+			)
+			return
+		}
+
+		if !peeled.IsZero() {
+			reference.Peeled = types.StringValue(peeled.String())
+		}
+
+		state.References = append(state.References, reference)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *refsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// peelReference follows symbolic references and annotated tags down to the
+// object they ultimately point to. It returns the zero hash when a symbolic
+// reference points to a missing reference.
+func peelReference(repo *git.Repository, ref *plumbing.Reference) (plumbing.Hash, error) {
+	if ref.Type() == plumbing.SymbolicReference {
+		resolved, err := repo.Reference(ref.Name(), true)
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			return plumbing.ZeroHash, nil
+		}
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+
+		ref = resolved
+	}
+
+	hash := ref.Hash()
+
+	for {
+		tag, err := repo.TagObject(hash)
+		if errors.Is(err, plumbing.ErrObjectNotFound) {
+			return hash, nil
+		}
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+
+		if tag.TargetType != plumbing.TagObject {
+			return tag.Target, nil
+		}
+
+		hash = tag.Target
+	}
+}
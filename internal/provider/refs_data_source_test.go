@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRefsDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first", "second")
+	commits := testAccCommitHashes(t, fixture)
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := plumbing.NewHash(commits[0])
+	second := plumbing.NewHash(commits[1])
+
+	for _, ref := range []*plumbing.Reference{
+		plumbing.NewHashReference("refs/pull/1/head", first),
+		plumbing.NewHashReference("refs/pull/1/merge", second),
+		plumbing.NewHashReference("refs/deploy/production/web", second),
+		plumbing.NewHashReference("refs/deploy/staging", first),
+		plumbing.NewSymbolicReference("refs/deploy/current", "refs/deploy/production/web"),
+		plumbing.NewSymbolicReference("refs/deploy/missing", "refs/deploy/nowhere"),
+	} {
+		if err := repo.Storer.SetReference(ref); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tag, err := repo.CreateTag("v1.0.0", first, &git.CreateTagOptions{
+		Tagger:  &testAccSignature,
+		Message: "v1.0.0",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_refs" "pulls" {
+  include = ["refs/pull/*/head"]
+}
+
+data "gitlocal_refs" "deploy" {
+  include = ["refs/deploy/**"]
+  exclude = ["refs/deploy/staging"]
+}
+
+data "gitlocal_refs" "tags" {
+  include = ["refs/tags/*"]
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_refs.pulls", "references.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_refs.pulls", "references.0.name", "refs/pull/1/head"),
+					resource.TestCheckResourceAttr("data.gitlocal_refs.pulls", "references.0.type", "hash"),
+					resource.TestCheckResourceAttr("data.gitlocal_refs.pulls", "references.0.target", commits[0]),
+					resource.TestCheckResourceAttr("data.gitlocal_refs.pulls", "references.0.peeled", commits[0]),
+
+					resource.TestCheckResourceAttr("data.gitlocal_refs.deploy", "references.#", "3"),
+					resource.TestCheckResourceAttr("data.gitlocal_refs.deploy", "references.0.name", "refs/deploy/current"),
+					resource.TestCheckResourceAttr("data.gitlocal_refs.deploy", "references.0.type", "symbolic"),
+					resource.TestCheckResourceAttr("data.gitlocal_refs.deploy", "references.0.target", "refs/deploy/production/web"),
+					resource.TestCheckResourceAttr("data.gitlocal_refs.deploy", "references.0.peeled", commits[1]),
+					resource.TestCheckResourceAttr("data.gitlocal_refs.deploy", "references.1.name", "refs/deploy/missing"),
+					resource.TestCheckNoResourceAttr("data.gitlocal_refs.deploy", "references.1.peeled"),
+					resource.TestCheckResourceAttr("data.gitlocal_refs.deploy", "references.2.name", "refs/deploy/production/web"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_refs.tags", "references.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_refs.tags", "references.0.target", tag.Hash().String()),
+					resource.TestCheckResourceAttr("data.gitlocal_refs.tags", "references.0.peeled", commits[0]),
+				),
+			},
+		},
+	})
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/go-git/go-git/v5"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -26,13 +25,14 @@ func NewRemoteDataSource() datasource.DataSource {
 
 // remoteDataSource is the data source implementation.
 type remoteDataSource struct {
-	repo *git.Repository
+	data *gitlocalProviderData
 }
 
 // remoteDataSourceModel maps the data source schema data.
 type remoteDataSourceModel struct {
-	Name types.String   `tfsdk:"name"`
-	Urls []types.String `tfsdk:"urls"`
+	Name           types.String   `tfsdk:"name"`
+	RepositoryPath types.String   `tfsdk:"repository_path"`
+	Urls           []types.String `tfsdk:"urls"`
 }
 
 // Metadata returns the data source type name.
@@ -42,8 +42,11 @@ func (d *remoteDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 
 // Schema defines the schema for the data source.
 func (d *remoteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := RemoteSchema(true)
+	attributes["repository_path"] = RepositoryPathAttribute()
+
 	resp.Schema = schema.Schema{
-		Attributes: RemoteSchema(true),
+		Attributes: attributes,
 	}
 }
 
@@ -53,9 +56,15 @@ func (d *remoteDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	remoteName := state.Name.ValueString()
 
-	remote, err := d.repo.Remote(remoteName)
+	remote, err := repo.Remote(remoteName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Git Remote `"+remoteName+"`",
@@ -69,7 +78,7 @@ func (d *remoteDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -84,15 +93,15 @@ func (d *remoteDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	repo, ok := req.ProviderData.(*git.Repository)
+	data, ok := req.ProviderData.(*gitlocalProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *git.Repository, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.repo = repo
+	d.data = data
 }
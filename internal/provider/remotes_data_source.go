@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/go-git/go-git/v5"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -26,12 +25,13 @@ func NewRemotesDataSource() datasource.DataSource {
 
 // remotesDataSource is the data source implementation.
 type remotesDataSource struct {
-	repo *git.Repository
+	data *gitlocalProviderData
 }
 
 // coffeesDataSourceModel maps the data source schema data.
 type remotesDataSourceModel struct {
-	Remotes []remotesModel `tfsdk:"remotes"`
+	Remotes        []remotesModel `tfsdk:"remotes"`
+	RepositoryPath types.String   `tfsdk:"repository_path"`
 }
 
 // remotesModel maps coffees schema data.
@@ -56,6 +56,7 @@ func (d *remotesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 					Attributes: RemoteSchema(false),
 				},
 			},
+			"repository_path": RepositoryPathAttribute(),
 		},
 	}
 }
@@ -65,7 +66,13 @@ func (d *remotesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	var state remotesDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	remotes, err := d.repo.Remotes()
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remotes, err := repo.Remotes()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Git Remotes",
@@ -89,7 +96,7 @@ func (d *remotesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -104,15 +111,15 @@ func (d *remotesDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	repo, ok := req.ProviderData.(*git.Repository)
+	data, ok := req.ProviderData.(*gitlocalProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *git.Repository, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.repo = repo
+	d.data = data
 }
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	formatcfg "github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &repositoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &repositoryDataSource{}
+)
+
+// NewRepositoryDataSource is a helper function to simplify the provider implementation.
+func NewRepositoryDataSource() datasource.DataSource {
+	return &repositoryDataSource{}
+}
+
+// repositoryDataSource is the data source implementation.
+type repositoryDataSource struct {
+	data *gitlocalProviderData
+}
+
+// repositoryDataSourceModel maps the data source schema data.
+type repositoryDataSourceModel struct {
+	Bare           types.Bool   `tfsdk:"bare"`
+	CommonDir      types.String `tfsdk:"common_dir"`
+	GitDir         types.String `tfsdk:"git_dir"`
+	ObjectFormat   types.String `tfsdk:"object_format"`
+	RepositoryPath types.String `tfsdk:"repository_path"`
+	WorktreeRoot   types.String `tfsdk:"worktree_root"`
+}
+
+// Metadata returns the data source type name.
+func (d *repositoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository"
+}
+
+// Schema defines the schema for the data source.
+func (d *repositoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Describes the layout of the repository as it was resolved by the provider.",
+		Attributes: map[string]schema.Attribute{
+			"bare": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the repository has no worktree",
+			},
+			"common_dir": schema.StringAttribute{
+				Computed:    true,
+				Description: "Path to the directory holding the objects and references shared by every worktree. Same as `git_dir` outside of linked worktrees",
+			},
+			"git_dir": schema.StringAttribute{
+				Computed:    true,
+				Description: "Path to the git directory",
+			},
+			"object_format": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash algorithm of the repository objects, either `sha1` or `sha256`",
+			},
+			"repository_path": RepositoryPathAttribute(),
+			"worktree_root": schema.StringAttribute{
+				Computed:    true,
+				Description: "Path to the root of the worktree. Null when the repository is bare",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *repositoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state repositoryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gitDir, err := repositoryGitDir(repo)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Directory",
+			err.Error(),
+		)
+		return
+	}
+
+	commonDir, err := readCommonDir(gitDir)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Common Directory",
+			err.Error(),
+		)
+		return
+	}
+
+	config, err := repo.Config()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Config",
+			err.Error(),
+		)
+		return
+	}
+
+	objectFormat := config.Extensions.ObjectFormat
+	if objectFormat == "" {
+		objectFormat = formatcfg.DefaultObjectFormat
+	}
+
+	state.WorktreeRoot = types.StringNull()
+
+	worktree, err := repo.Worktree()
+	switch {
+	case errors.Is(err, git.ErrIsBareRepository):
+		// Bare repositories have no worktree root.
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Worktree",
+			err.Error(),
+		)
+		return
+	default:
+		state.WorktreeRoot = types.StringValue(worktree.Filesystem.Root())
+	}
+
+	state.Bare = types.BoolValue(state.WorktreeRoot.IsNull())
+	state.CommonDir = types.StringValue(commonDir)
+	state.GitDir = types.StringValue(gitDir)
+	state.ObjectFormat = types.StringValue(string(objectFormat))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *repositoryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
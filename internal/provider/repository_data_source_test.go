@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRepositoryDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+
+	bare := filepath.Join(t.TempDir(), "bare.git")
+	if _, err := git.PlainClone(bare, true, &git.CloneOptions{URL: fixture}); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_repository" "test" { }
+
+data "gitlocal_repository" "bare" {
+  repository_path = %q
+}
+`, fixture, bare),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_repository.test", "bare", "false"),
+					resource.TestCheckResourceAttr("data.gitlocal_repository.test", "worktree_root", fixture),
+					resource.TestCheckResourceAttr("data.gitlocal_repository.test", "git_dir", filepath.Join(fixture, ".git")),
+					resource.TestCheckResourceAttr("data.gitlocal_repository.test", "common_dir", filepath.Join(fixture, ".git")),
+					resource.TestCheckResourceAttr("data.gitlocal_repository.test", "object_format", "sha1"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_repository.bare", "bare", "true"),
+					resource.TestCheckNoResourceAttr("data.gitlocal_repository.bare", "worktree_root"),
+					resource.TestCheckResourceAttr("data.gitlocal_repository.bare", "git_dir", bare),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &repositoryResource{}
+	_ resource.ResourceWithValidateConfig = &repositoryResource{}
+)
+
+// NewRepositoryResource is a helper function to simplify the provider implementation.
+func NewRepositoryResource() resource.Resource {
+	return &repositoryResource{}
+}
+
+// repositoryResource is the resource implementation.
+type repositoryResource struct{}
+
+// repositoryResourceModel maps the resource schema data.
+type repositoryResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Path            types.String `tfsdk:"path"`
+	URL             types.String `tfsdk:"url"`
+	Bare            types.Bool   `tfsdk:"bare"`
+	InitialBranch   types.String `tfsdk:"initial_branch"`
+	Depth           types.Int64  `tfsdk:"depth"`
+	SingleBranch    types.Bool   `tfsdk:"single_branch"`
+	RemoveOnDestroy types.Bool   `tfsdk:"remove_on_destroy"`
+}
+
+// Metadata returns the resource type name.
+func (r *repositoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository"
+}
+
+// Schema defines the schema for the resource.
+func (r *repositoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Initializes an empty repository, or clones an existing local repository, at the given path.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Path of the repository",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path where the repository is created. The path must not exist or be an empty directory, so removing the repository on destroy never removes other files",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "Local path or `file://` URL of the repository to clone. When unset, an empty repository is initialized",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bare": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the repository is created without a worktree. Defaults to `false`",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"initial_branch": schema.StringAttribute{
+				Description: "Name of the initial branch. When cloning, this is the branch to check out instead of the remote HEAD",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"depth": schema.Int64Attribute{
+				Description: "Limit the clone to the given number of commits. Requires `url`",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"single_branch": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Only fetch the branch being checked out when cloning. Requires `url`. Defaults to `false`",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"remove_on_destroy": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the repository is removed from disk when the resource is destroyed. When `false`, the repository is left on disk. Defaults to `false`",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the clone options against the repository source.
+func (r *repositoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config repositoryResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.URL.IsNull() && !config.URL.IsUnknown() {
+		endpoint, err := transport.NewEndpoint(config.URL.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("url"),
+				"Invalid Repository URL",
+				err.Error(),
+			)
+		} else if endpoint.Protocol != "file" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("url"),
+				"Unsupported Repository URL",
+				"Only local paths and file:// URLs can be cloned, got protocol `"+endpoint.Protocol+"`.",
+			)
+		}
+	}
+
+	if config.URL.IsNull() {
+		if !config.Depth.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("depth"),
+				"Invalid Attribute Combination",
+				"The depth can only be set when cloning a repository from `url`.",
+			)
+		}
+
+		if config.SingleBranch.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("single_branch"),
+				"Invalid Attribute Combination",
+				"A single branch can only be fetched when cloning a repository from `url`.",
+			)
+		}
+	}
+
+	if !config.Depth.IsNull() && !config.Depth.IsUnknown() && config.Depth.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("depth"),
+			"Invalid Depth",
+			"The depth must be at least 1.",
+		)
+	}
+}
+
+// Create initializes or clones the repository and sets the initial Terraform state.
+func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan repositoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath := plan.Path.ValueString()
+	isBare := plan.Bare.ValueBool()
+
+	// Refuse to create the repository among existing files, which
+	// remove_on_destroy would otherwise remove with it.
+	entries, err := os.ReadDir(repoPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Unable to Read `"+repoPath+"`",
+			err.Error(),
+		)
+		return
+	}
+	if len(entries) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Path Not Empty",
+			"The repository can only be created in a path that does not exist or is an empty directory, but `"+repoPath+"` contains files.",
+		)
+		return
+	}
+
+	if plan.URL.IsNull() {
+		options := &git.PlainInitOptions{
+			Bare: isBare,
+		}
+		if !plan.InitialBranch.IsNull() {
+			options.DefaultBranch = plumbing.NewBranchReferenceName(plan.InitialBranch.ValueString())
+		}
+
+		_, err = git.PlainInitWithOptions(repoPath, options)
+	} else {
+		options := &git.CloneOptions{
+			URL:          plan.URL.ValueString(),
+			Depth:        int(plan.Depth.ValueInt64()),
+			SingleBranch: plan.SingleBranch.ValueBool(),
+		}
+		if !plan.InitialBranch.IsNull() {
+			options.ReferenceName = plumbing.NewBranchReferenceName(plan.InitialBranch.ValueString())
+		}
+
+		_, err = git.PlainCloneContext(ctx, repoPath, isBare, options)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Git Repository `"+repoPath+"`",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.Path
+
+	// Set state
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state repositoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoPath := state.Path.ValueString()
+
+	_, err := git.PlainOpen(repoPath)
+	if errors.Is(err, git.ErrRepositoryNotExists) || errors.Is(err, os.ErrNotExist) {
+		// The repository was removed outside of Terraform.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Open Git Repository `"+repoPath+"`",
+			err.Error(),
+		)
+		return
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Every attribute other than remove_on_destroy forces a replacement, so only
+// the state needs updating.
+func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan repositoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the repository from disk when remove_on_destroy is set, and
+// otherwise only removes it from the Terraform state.
+func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state repositoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.RemoveOnDestroy.ValueBool() {
+		return
+	}
+
+	repoPath := state.Path.ValueString()
+
+	if err := os.RemoveAll(repoPath); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Remove Git Repository `"+repoPath+"`",
+			err.Error(),
+		)
+		return
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestRepositoryResource(t *testing.T) {
+	source := testAccRepositoryFixture(t, "first", "second")
+
+	// Clone the fixture to a bare repository to use as the clone source.
+	bareSource := filepath.Join(t.TempDir(), "source.git")
+	if _, err := git.PlainClone(bareSource, true, &git.CloneOptions{URL: source}); err != nil {
+		t.Fatal(err)
+	}
+
+	target := t.TempDir()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if _, err := os.Stat(filepath.Join(target, "clone")); err != nil {
+				return fmt.Errorf("expected kept repository to remain on disk: %w", err)
+			}
+
+			if _, err := os.Stat(filepath.Join(target, "init")); !errors.Is(err, os.ErrNotExist) {
+				return errors.New("expected removed repository to be deleted from disk")
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "gitlocal_repository" "clone" {
+  path          = "%[1]s/clone"
+  url           = "file://%[2]s"
+  depth         = 1
+  single_branch = true
+}
+
+resource "gitlocal_repository" "init" {
+  path              = "%[1]s/init"
+  bare              = true
+  initial_branch    = "main"
+  remove_on_destroy = true
+}
+`, target, bareSource),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlocal_repository.clone", "id", target+"/clone"),
+					resource.TestCheckResourceAttr("gitlocal_repository.clone", "bare", "false"),
+					resource.TestCheckResourceAttr("gitlocal_repository.clone", "remove_on_destroy", "false"),
+					resource.TestCheckResourceAttr("gitlocal_repository.init", "id", target+"/init"),
+					resource.TestCheckResourceAttr("gitlocal_repository.init", "bare", "true"),
+					func(_ *terraform.State) error {
+						head, err := os.ReadFile(filepath.Join(target, "init", "HEAD"))
+						if err != nil {
+							return err
+						}
+
+						if string(head) != "ref: refs/heads/main\n" {
+							return fmt.Errorf("unexpected HEAD: %q", head)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestRepositoryResourceNonEmptyPath(t *testing.T) {
+	target := t.TempDir()
+	existing := filepath.Join(target, "main.tf")
+
+	if err := os.WriteFile(existing, []byte("# existing configuration\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if _, err := os.Stat(existing); err != nil {
+				return fmt.Errorf("expected existing file to remain on disk: %w", err)
+			}
+
+			if _, err := os.Stat(filepath.Join(target, "scratch")); !errors.Is(err, os.ErrNotExist) {
+				return errors.New("expected removed repository to be deleted from disk")
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "gitlocal_repository" "test" {
+  path              = %q
+  remove_on_destroy = true
+}
+`, target),
+				ExpectError: regexp.MustCompile(`Path Not Empty`),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "gitlocal_repository" "test" {
+  path              = "%s/scratch"
+  remove_on_destroy = true
+}
+`, target),
+				Check: resource.TestCheckResourceAttr("gitlocal_repository.test", "id", target+"/scratch"),
+			},
+		},
+	})
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/go-version"
+)
+
+// semverPattern matches a semantic version with its three components, as
+// defined by https://semver.org.
+var semverPattern = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`)
+
+// semverTag is a tag whose name is a semantic version.
+type semverTag struct {
+	commit  plumbing.Hash
+	name    string
+	version *version.Version
+}
+
+// parseSemverTag returns the semantic version of the tag name made of prefix
+// followed by a version, and false when the name is not such a tag.
+func parseSemverTag(name string, prefix string) (*version.Version, bool) {
+	s, ok := strings.CutPrefix(name, prefix)
+	if !ok || !semverPattern.MatchString(s) {
+		return nil, false
+	}
+
+	v, err := version.NewSemver(s)
+	if err != nil {
+		return nil, false
+	}
+
+	return v, true
+}
+
+// listSemverTags returns the tags of repo made of prefix followed by a semantic
+// version and pointing at a commit, highest version first. Pre-releases are
+// skipped unless includePrerelease is true, and tags of commits that are not
+// ancestors of reachableFrom are skipped unless it is nil.
+func listSemverTags(repo *git.Repository, prefix string, includePrerelease bool, reachableFrom *object.Commit) ([]semverTag, error) {
+	var reachable map[plumbing.Hash]bool
+
+	if reachableFrom != nil {
+		var err error
+
+		reachable, err = ancestorCommits(repo, reachableFrom)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	refs, err := repo.Tags()
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []semverTag
+
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+
+		v, ok := parseSemverTag(name, prefix)
+		if !ok || (v.Prerelease() != "" && !includePrerelease) {
+			return nil
+		}
+
+		commit, err := peelReference(repo, ref)
+		if err != nil {
+			return err
+		}
+
+		if reachable != nil && !reachable[commit] {
+			return nil
+		}
+
+		if _, err := repo.CommitObject(commit); errors.Is(err, plumbing.ErrObjectNotFound) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		tags = append(tags, semverTag{commit: commit, name: name, version: v})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		if c := tags[i].version.Compare(tags[j].version); c != 0 {
+			return c > 0
+		}
+
+		return tags[i].name < tags[j].name
+	})
+
+	return tags, nil
+}
+
+// logCommits returns an iterator over commit and its ancestors, in the order
+// of repo.Log, that stops at the boundary of a shallow clone instead of
+// failing on the missing parents of its shallow commits, as git does. Only the
+// commits changing a file matched by pathFilter are returned when it is not
+// nil.
+func logCommits(repo *git.Repository, commit *object.Commit, pathFilter func(string) bool) (object.CommitIter, error) {
+	shallow, err := repo.Storer.Shallow()
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []plumbing.Hash
+
+	for _, hash := range shallow {
+		c, err := repo.CommitObject(hash)
+		if errors.Is(err, plumbing.ErrObjectNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		missing = append(missing, c.ParentHashes...)
+	}
+
+	iter := object.NewCommitPreorderIter(commit, nil, missing)
+	if pathFilter != nil {
+		iter = object.NewCommitPathIterFromIter(pathFilter, iter, false)
+	}
+
+	return iter, nil
+}
+
+// ancestorCommits returns the hashes of commit and all of its ancestors.
+func ancestorCommits(repo *git.Repository, commit *object.Commit) (map[plumbing.Hash]bool, error) {
+	commits, err := logCommits(repo, commit, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer commits.Close()
+
+	ancestors := map[plumbing.Hash]bool{}
+
+	for {
+		c, err := commits.Next()
+		if errors.Is(err, io.EOF) {
+			return ancestors, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		ancestors[c.Hash] = true
+	}
+}
+
+// commitsSince returns the commits reachable from commit but not from since,
+// most recent first. Every commit reachable from commit is returned when since
+// is the zero hash, and only the commits changing a file matched by pathFilter
+// when it is not nil.
+func commitsSince(repo *git.Repository, commit *object.Commit, since plumbing.Hash, pathFilter func(string) bool) ([]*object.Commit, error) {
+	excluded := map[plumbing.Hash]bool{}
+
+	if !since.IsZero() {
+		sinceCommit, err := repo.CommitObject(since)
+		if err != nil {
+			return nil, err
+		}
+
+		if excluded, err = ancestorCommits(repo, sinceCommit); err != nil {
+			return nil, err
+		}
+	}
+
+	iter, err := logCommits(repo, commit, pathFilter)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var commits []*object.Commit
+
+	for {
+		c, err := iter.Next()
+		if errors.Is(err, io.EOF) {
+			return commits, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !excluded[c.Hash] {
+			commits = append(commits, c)
+		}
+	}
+}
+
+// bumpVersion returns v with the component named by bump incremented and the
+// lower components reset, dropping any pre-release or build metadata. It
+// returns v unchanged when bump is not `major`, `minor` or `patch`.
+func bumpVersion(v *version.Version, bump string) string {
+	segments := v.Segments64()
+	major, minor, patch := segments[0], segments[1], segments[2]
+
+	switch bump {
+	case "major":
+		major, minor, patch = major+1, 0, 0
+	case "minor":
+		minor, patch = minor+1, 0
+	case "patch":
+		patch++
+	default:
+		return v.Original()
+	}
+
+	return fmt.Sprintf("%d.%d.%d", major, minor, patch)
+}
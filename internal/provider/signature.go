@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// signatureModel maps the signature schema data shared by the data sources
+// reading signed objects.
+type signatureModel struct {
+	AllowedSigners     types.String `tfsdk:"allowed_signers"`
+	AllowedSignersFile types.String `tfsdk:"allowed_signers_file"`
+	Keyring            types.String `tfsdk:"keyring"`
+	KeyringFile        types.String `tfsdk:"keyring_file"`
+	Signature          types.String `tfsdk:"signature"`
+	SignatureVerified  types.Bool   `tfsdk:"signature_verified"`
+	SignerIdentity     types.String `tfsdk:"signer_identity"`
+	SignerKeyID        types.String `tfsdk:"signer_key_id"`
+}
+
+// SignatureAttributes returns the schema of the attributes describing and
+// verifying the signature of a commit or annotated tag.
+func SignatureAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"allowed_signers": schema.StringAttribute{
+			Description: "SSH keys trusted to sign, in the allowed signers format of `gpg.ssh.allowedSignersFile`. Conflicts with `allowed_signers_file`",
+			Optional:    true,
+		},
+		"allowed_signers_file": schema.StringAttribute{
+			Description: "Path to an allowed signers file listing the SSH keys trusted to sign, as set in `gpg.ssh.allowedSignersFile`. Conflicts with `allowed_signers`",
+			Optional:    true,
+		},
+		"keyring": schema.StringAttribute{
+			Description: "ASCII armored PGP public keys trusted to sign. Conflicts with `keyring_file`",
+			Optional:    true,
+		},
+		"keyring_file": schema.StringAttribute{
+			Description: "Path to a file holding ASCII armored PGP public keys trusted to sign. Conflicts with `keyring`",
+			Optional:    true,
+		},
+		"signature": schema.StringAttribute{
+			Computed:    true,
+			Description: "Raw signature, either PGP or SSH. Null when the object is not signed",
+		},
+		"signature_verified": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the signature was made by one of the trusted keys. False when the object is not signed or no trusted keys are set",
+		},
+		"signer_identity": schema.StringAttribute{
+			Computed:    true,
+			Description: "Primary identity of the trusted PGP key that made the signature, or the comma separated principals of the allowed SSH signer. Null unless the signature is verified",
+		},
+		"signer_key_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Long ID of the trusted PGP key that made the signature in uppercase hexadecimal, or the SHA256 fingerprint of the SSH key. Null unless the signature is verified",
+		},
+	}
+}
+
+// trustedKeys holds the keys signatures are verified against.
+type trustedKeys struct {
+	allowedSigners []allowedSigner
+	keyring        string
+}
+
+// signedObject is a commit or annotated tag whose signature can be verified.
+type signedObject struct {
+	encode    func(plumbing.EncodedObject) error
+	signature string
+	verifyPGP func(string) (*openpgp.Entity, error)
+	when      time.Time
+}
+
+func commitSignedObject(commit *object.Commit) signedObject {
+	return signedObject{
+		encode:    commit.EncodeWithoutSignature,
+		signature: commit.PGPSignature,
+		verifyPGP: commit.Verify,
+		when:      commit.Committer.When,
+	}
+}
+
+func tagSignedObject(tag *object.Tag) signedObject {
+	return signedObject{
+		encode:    tag.EncodeWithoutSignature,
+		signature: tag.PGPSignature,
+		verifyPGP: tag.Verify,
+		when:      tag.Tagger.When,
+	}
+}
+
+// payload returns the encoded object without its signature, which is the
+// message the signature was made over.
+func (o signedObject) payload() ([]byte, error) {
+	encoded := &plumbing.MemoryObject{}
+	if err := o.encode(encoded); err != nil {
+		return nil, err
+	}
+
+	reader, err := encoded.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
+// validateSignatureConfig reports conflicting trusted key settings.
+func validateSignatureConfig(config signatureModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !config.Keyring.IsNull() && !config.KeyringFile.IsNull() {
+		diags.AddAttributeError(
+			path.Root("keyring_file"),
+			"Conflicting Keyring Configuration",
+			"Only one of `keyring` or `keyring_file` can be set.",
+		)
+	}
+
+	if !config.AllowedSigners.IsNull() && !config.AllowedSignersFile.IsNull() {
+		diags.AddAttributeError(
+			path.Root("allowed_signers_file"),
+			"Conflicting Allowed Signers Configuration",
+			"Only one of `allowed_signers` or `allowed_signers_file` can be set.",
+		)
+	}
+
+	return diags
+}
+
+// readTrustedKeys returns the trusted keys configured in model.
+func readTrustedKeys(model signatureModel) (trustedKeys, diag.Diagnostics) {
+	var keys trustedKeys
+
+	keyring, attribute, diags := readInlineOrFile(model.Keyring, "keyring", model.KeyringFile, "keyring_file")
+	if diags.HasError() {
+		return keys, diags
+	}
+
+	// Parse the keys up front, so that a malformed keyring is reported rather
+	// than treated as a signature that failed to verify.
+	if keyring != "" {
+		if _, err := openpgp.ReadArmoredKeyRing(strings.NewReader(keyring)); err != nil {
+			diags.AddAttributeError(
+				attribute,
+				"Invalid Keyring",
+				"The keyring must hold ASCII armored PGP public keys: "+err.Error(),
+			)
+			return keys, diags
+		}
+	}
+
+	keys.keyring = keyring
+
+	allowedSigners, attribute, d := readInlineOrFile(model.AllowedSigners, "allowed_signers", model.AllowedSignersFile, "allowed_signers_file")
+	diags.Append(d...)
+	if diags.HasError() {
+		return keys, diags
+	}
+
+	signers, err := parseAllowedSigners(allowedSigners)
+	if err != nil {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid Allowed Signers",
+			"The allowed signers must follow the ALLOWED SIGNERS format of ssh-keygen: "+err.Error(),
+		)
+		return keys, diags
+	}
+
+	keys.allowedSigners = signers
+
+	return keys, diags
+}
+
+// readInlineOrFile returns the value of the inline attribute, or the content of
+// the file named by the file attribute, along with the attribute it came from.
+func readInlineOrFile(inline types.String, inlineName string, file types.String, fileName string) (string, path.Path, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if file.IsNull() {
+		return inline.ValueString(), path.Root(inlineName), diags
+	}
+
+	content, err := os.ReadFile(file.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(fileName),
+			"Unable to Read `"+fileName+"`",
+			err.Error(),
+		)
+	}
+
+	return string(content), path.Root(fileName), diags
+}
+
+// verifySignature sets the signature attributes of model from the signature of
+// obj, verified against the PGP keyring or the allowed SSH signers depending on
+// the signature format.
+func verifySignature(model *signatureModel, obj signedObject, keys trustedKeys) {
+	model.Signature = types.StringNull()
+	model.SignatureVerified = types.BoolValue(false)
+	model.SignerIdentity = types.StringNull()
+	model.SignerKeyID = types.StringNull()
+
+	if obj.signature == "" {
+		return
+	}
+
+	model.Signature = types.StringValue(obj.signature)
+
+	if isSSHSignature(obj.signature) {
+		if len(keys.allowedSigners) == 0 {
+			return
+		}
+
+		message, err := obj.payload()
+		if err != nil {
+			return
+		}
+
+		principals, fingerprint, err := verifySSHSignature(obj.signature, message, keys.allowedSigners, obj.when)
+		if err != nil {
+			return
+		}
+
+		model.SignatureVerified = types.BoolValue(true)
+		model.SignerIdentity = types.StringValue(strings.Join(principals, ","))
+		model.SignerKeyID = types.StringValue(fingerprint)
+
+		return
+	}
+
+	if keys.keyring == "" {
+		return
+	}
+
+	entity, err := obj.verifyPGP(keys.keyring)
+	if err != nil {
+		return
+	}
+
+	model.SignatureVerified = types.BoolValue(true)
+
+	if identity := entity.PrimaryIdentity(); identity != nil {
+		model.SignerIdentity = types.StringValue(identity.Name)
+	}
+
+	// The signature may come from a subkey, so the issuer recorded in the
+	// signature is reported rather than the primary key.
+	keyID := entity.PrimaryKey.KeyId
+	if issuer := signatureIssuer(obj.signature); issuer != nil {
+		keyID = *issuer
+	}
+
+	model.SignerKeyID = types.StringValue(fmt.Sprintf("%016X", keyID))
+}
+
+// signatureIssuer returns the key ID recorded in an armored PGP signature, or
+// nil when it cannot be read.
+func signatureIssuer(rawSignature string) *uint64 {
+	block, err := armor.Decode(strings.NewReader(rawSignature))
+	if err != nil {
+		return nil
+	}
+
+	p, err := packet.Read(block.Body)
+	if err != nil {
+		return nil
+	}
+
+	signature, ok := p.(*packet.Signature)
+	if !ok {
+		return nil
+	}
+
+	return signature.IssuerKeyId
+}
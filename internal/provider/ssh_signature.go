@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	pathpkg "path"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// sshSignaturePEMType is the armor type of SSH signatures.
+	sshSignaturePEMType = "SSH SIGNATURE"
+
+	// sshSignatureMagic prefixes SSH signatures and the data they sign.
+	sshSignatureMagic = "SSHSIG"
+
+	// sshSignatureNamespace is the namespace git signs objects in.
+	sshSignatureNamespace = "git"
+
+	// allowedSignersTimeFormat is the layout of the valid-after and valid-before
+	// options of allowed signers.
+	allowedSignersTimeFormat = "20060102150405"
+)
+
+// allowedSigner is an entry of an allowed signers file, as described in the
+// ALLOWED SIGNERS section of ssh-keygen(1).
+type allowedSigner struct {
+	principals  []string
+	namespaces  []string
+	validAfter  time.Time
+	validBefore time.Time
+	key         ssh.PublicKey
+}
+
+// sshSignature is the decoded blob of an armored SSH signature.
+type sshSignature struct {
+	Magic         [6]byte
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      []byte
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// sshSignedData is the data an SSH signature is computed over.
+type sshSignedData struct {
+	Magic         [6]byte
+	Namespace     string
+	Reserved      []byte
+	HashAlgorithm string
+	Hash          []byte
+}
+
+// isSSHSignature reports whether rawSignature is an armored SSH signature.
+func isSSHSignature(rawSignature string) bool {
+	return strings.HasPrefix(strings.TrimSpace(rawSignature), "-----BEGIN "+sshSignaturePEMType+"-----")
+}
+
+// parseAllowedSigners parses the content of an allowed signers file. Lines for
+// certificate authorities are skipped, as only plain keys are supported.
+func parseAllowedSigners(content string) ([]allowedSigner, error) {
+	var signers []allowedSigner
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		signer, ok, err := parseAllowedSignerLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", number, err)
+		}
+
+		if ok {
+			signers = append(signers, signer)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return signers, nil
+}
+
+// parseAllowedSignerLine parses a single line of an allowed signers file. It
+// returns false for lines that should be skipped.
+func parseAllowedSignerLine(line string) (allowedSigner, bool, error) {
+	var signer allowedSigner
+
+	principals, rest := nextAllowedSignersField(line)
+	signer.principals = strings.Split(strings.Trim(principals, `"`), ",")
+
+	// The remainder follows the authorized keys format, with options before
+	// the key type.
+	key, _, options, _, err := ssh.ParseAuthorizedKey([]byte(rest))
+	if err != nil {
+		return signer, false, err
+	}
+
+	signer.key = key
+
+	for _, option := range options {
+		name, value, _ := strings.Cut(option, "=")
+		value = strings.Trim(value, `"`)
+
+		switch strings.ToLower(name) {
+		case "cert-authority":
+			return signer, false, nil
+		case "namespaces":
+			signer.namespaces = strings.Split(value, ",")
+		case "valid-after":
+			if signer.validAfter, err = parseAllowedSignersTime(value); err != nil {
+				return signer, false, fmt.Errorf("invalid valid-after option: %w", err)
+			}
+		case "valid-before":
+			if signer.validBefore, err = parseAllowedSignersTime(value); err != nil {
+				return signer, false, fmt.Errorf("invalid valid-before option: %w", err)
+			}
+		default:
+			return signer, false, fmt.Errorf("unsupported option %q", name)
+		}
+	}
+
+	return signer, true, nil
+}
+
+// nextAllowedSignersField splits the first whitespace separated field off line,
+// keeping quoted whitespace in the field.
+func nextAllowedSignersField(line string) (string, string) {
+	quoted := false
+
+	for i, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case (r == ' ' || r == '\t') && !quoted:
+			return line[:i], strings.TrimSpace(line[i:])
+		}
+	}
+
+	return line, ""
+}
+
+// parseAllowedSignersTime parses a YYYYMMDD[HHMM[SS]] timestamp, in UTC when
+// suffixed with Z and in local time otherwise.
+func parseAllowedSignersTime(value string) (time.Time, error) {
+	location := time.Local
+
+	if trimmed, ok := strings.CutSuffix(value, "Z"); ok {
+		value = trimmed
+		location = time.UTC
+	}
+
+	switch len(value) {
+	case 8:
+		value += "000000"
+	case 12:
+		value += "00"
+	case 14:
+	default:
+		return time.Time{}, fmt.Errorf("expected YYYYMMDD[HHMM[SS]][Z], got %q", value)
+	}
+
+	return time.ParseInLocation(allowedSignersTimeFormat, value, location)
+}
+
+// verifySSHSignature checks an armored SSH signature of message made in the git
+// namespace against the allowed signers, at the time the object was signed. It
+// returns the principals allowed to use the signing key and its fingerprint.
+func verifySSHSignature(rawSignature string, message []byte, signers []allowedSigner, when time.Time) ([]string, string, error) {
+	block, _ := pem.Decode([]byte(rawSignature))
+	if block == nil || block.Type != sshSignaturePEMType {
+		return nil, "", errors.New("malformed SSH signature armor")
+	}
+
+	var signature sshSignature
+	if err := ssh.Unmarshal(block.Bytes, &signature); err != nil {
+		return nil, "", err
+	}
+
+	if string(signature.Magic[:]) != sshSignatureMagic || signature.Version != 1 {
+		return nil, "", errors.New("unsupported SSH signature format")
+	}
+
+	if signature.Namespace != sshSignatureNamespace {
+		return nil, "", fmt.Errorf("signature was made in namespace %q, expected %q", signature.Namespace, sshSignatureNamespace)
+	}
+
+	key, err := ssh.ParsePublicKey(signature.PublicKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var h hash.Hash
+	switch signature.HashAlgorithm {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return nil, "", fmt.Errorf("unsupported hash algorithm %q", signature.HashAlgorithm)
+	}
+	h.Write(message)
+
+	signed := ssh.Marshal(sshSignedData{
+		Magic:         signature.Magic,
+		Namespace:     signature.Namespace,
+		Reserved:      signature.Reserved,
+		HashAlgorithm: signature.HashAlgorithm,
+		Hash:          h.Sum(nil),
+	})
+
+	sig := new(ssh.Signature)
+	if err := ssh.Unmarshal(signature.Signature, sig); err != nil {
+		return nil, "", err
+	}
+
+	if err := key.Verify(signed, sig); err != nil {
+		return nil, "", err
+	}
+
+	var principals []string
+	for _, signer := range signers {
+		if !bytes.Equal(signer.key.Marshal(), key.Marshal()) || !signer.allows(when) {
+			continue
+		}
+
+		principals = append(principals, signer.principals...)
+	}
+
+	if len(principals) == 0 {
+		return nil, "", errors.New("signing key is not an allowed signer")
+	}
+
+	return principals, ssh.FingerprintSHA256(key), nil
+}
+
+// allows reports whether the signer may sign git objects at the given time.
+func (s allowedSigner) allows(when time.Time) bool {
+	if len(s.namespaces) > 0 {
+		allowed := false
+		for _, namespace := range s.namespaces {
+			if ok, _ := pathpkg.Match(namespace, sshSignatureNamespace); ok {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return false
+		}
+	}
+
+	if !s.validAfter.IsZero() && when.Before(s.validAfter) {
+		return false
+	}
+
+	if !s.validBefore.IsZero() && when.After(s.validBefore) {
+		return false
+	}
+
+	return true
+}
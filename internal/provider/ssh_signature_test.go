@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAllowedSigners(t *testing.T) {
+	_, first := testAccNewSSHSigner(t)
+	_, second := testAccNewSSHSigner(t)
+
+	signers, err := parseAllowedSigners(strings.Join([]string{
+		"# comment",
+		"",
+		"jane@example.com " + first,
+		`"john@example.com,*@ops.example.com" namespaces="git,file",valid-after="20240101",valid-before="20250101Z" ` + second,
+		"*@example.com cert-authority " + second,
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(signers) != 2 {
+		t.Fatalf("expected 2 signers, got %d", len(signers))
+	}
+
+	if got := strings.Join(signers[0].principals, ","); got != "jane@example.com" {
+		t.Errorf("unexpected principals %q", got)
+	}
+
+	if got := strings.Join(signers[1].principals, ","); got != "john@example.com,*@ops.example.com" {
+		t.Errorf("unexpected principals %q", got)
+	}
+
+	if got := strings.Join(signers[1].namespaces, ","); got != "git,file" {
+		t.Errorf("unexpected namespaces %q", got)
+	}
+
+	if !signers[1].validBefore.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected valid-before %s", signers[1].validBefore)
+	}
+
+	if signers[1].allows(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected the signer to be expired")
+	}
+
+	if _, err := parseAllowedSigners("jane@example.com unknown-option " + first); err == nil {
+		t.Error("expected an error for an unsupported option")
+	}
+}
+
+func TestVerifySSHSignature(t *testing.T) {
+	signer, publicKey := testAccNewSSHSigner(t)
+	_, otherKey := testAccNewSSHSigner(t)
+
+	message := []byte("tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n")
+
+	signature, err := signer.Sign(strings.NewReader(string(message)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !isSSHSignature(string(signature)) {
+		t.Fatal("expected an SSH signature")
+	}
+
+	allowed, err := parseAllowedSigners("jane@example.com " + publicKey + "\njohn@example.com " + otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	principals, fingerprint, err := verifySSHSignature(string(signature), message, allowed, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(principals, ",") != "jane@example.com" {
+		t.Errorf("unexpected principals %v", principals)
+	}
+
+	if !strings.HasPrefix(fingerprint, "SHA256:") {
+		t.Errorf("unexpected fingerprint %q", fingerprint)
+	}
+
+	if _, _, err := verifySSHSignature(string(signature), []byte("tampered"), allowed, time.Now()); err == nil {
+		t.Error("expected an error for a tampered message")
+	}
+
+	if _, _, err := verifySSHSignature(string(signature), message, allowed[1:], time.Now()); err == nil {
+		t.Error("expected an error for a key that is not allowed")
+	}
+}
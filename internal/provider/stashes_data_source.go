@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &stashesDataSource{}
+	_ datasource.DataSourceWithConfigure = &stashesDataSource{}
+)
+
+// NewStashesDataSource is a helper function to simplify the provider implementation.
+func NewStashesDataSource() datasource.DataSource {
+	return &stashesDataSource{}
+}
+
+// stashesDataSource is the data source implementation.
+type stashesDataSource struct {
+	data *gitlocalProviderData
+}
+
+// stashesDataSourceModel maps the data source schema data.
+type stashesDataSourceModel struct {
+	RepositoryPath types.String   `tfsdk:"repository_path"`
+	Stashes        []stashesModel `tfsdk:"stashes"`
+}
+
+// stashesModel maps stash schema data.
+type stashesModel struct {
+	BaseCommit types.String `tfsdk:"base_commit"`
+	Date       types.String `tfsdk:"date"`
+	Hash       types.String `tfsdk:"hash"`
+	Index      types.Int64  `tfsdk:"index"`
+	Message    types.String `tfsdk:"message"`
+}
+
+// Metadata returns the data source type name.
+func (d *stashesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stashes"
+}
+
+// Schema defines the schema for the data source.
+func (d *stashesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the stash entries of the repository, as recorded in the reflog of `refs/stash`.",
+		Attributes: map[string]schema.Attribute{
+			"repository_path": RepositoryPathAttribute(),
+			"stashes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of stash entries, most recent first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"base_commit": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash of the commit HEAD pointed to when the changes were stashed",
+						},
+						"date": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date of the stash in RFC 3339",
+						},
+						"hash": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash of the stash commit",
+						},
+						"index": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Index of the stash entry, as in `stash@{index}`",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Message of the stash entry",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *stashesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state stashesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := readReflog(repo, plumbing.ReferenceName("refs/stash"))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Stashes",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Stashes = nil
+
+	for index, entry := range entries {
+		commit, err := repo.CommitObject(entry.New)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to Read Stash Commit `stash@{%d}`", index),
+				err.Error(),
+			)
+			return
+		}
+
+		stashState := stashesModel{
+			BaseCommit: types.StringNull(),
+			Date:       types.StringValue(entry.Committer.When.Format(time.RFC3339)),
+			Hash:       types.StringValue(entry.New.String()),
+			Index:      types.Int64Value(int64(index)),
+			Message:    types.StringValue(entry.Message),
+		}
+
+		if len(commit.ParentHashes) > 0 {
+			stashState.BaseCommit = types.StringValue(commit.ParentHashes[0].String())
+		}
+
+		state.Stashes = append(state.Stashes, stashState)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *stashesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestStashesDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	head := testAccCommitHashes(t, fixture)[0]
+
+	first := testAccStashFixture(t, fixture, "On master: first")
+	second := testAccStashFixture(t, fixture, "WIP on master: second")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_stashes" "test" { }
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_stashes.test", "stashes.#", "2"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_stashes.test", "stashes.0.index", "0"),
+					resource.TestCheckResourceAttr("data.gitlocal_stashes.test", "stashes.0.hash", second.String()),
+					resource.TestCheckResourceAttr("data.gitlocal_stashes.test", "stashes.0.message", "WIP on master: second"),
+					resource.TestCheckResourceAttr("data.gitlocal_stashes.test", "stashes.0.base_commit", head),
+					resource.TestCheckResourceAttr("data.gitlocal_stashes.test", "stashes.0.date", "2025-01-02T03:04:05Z"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_stashes.test", "stashes.1.index", "1"),
+					resource.TestCheckResourceAttr("data.gitlocal_stashes.test", "stashes.1.hash", first.String()),
+					resource.TestCheckResourceAttr("data.gitlocal_stashes.test", "stashes.1.message", "On master: first"),
+				),
+			},
+		},
+	})
+}
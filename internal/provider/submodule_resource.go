@@ -0,0 +1,711 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	format "github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &submoduleResource{}
+	_ resource.ResourceWithConfigure      = &submoduleResource{}
+	_ resource.ResourceWithImportState    = &submoduleResource{}
+	_ resource.ResourceWithValidateConfig = &submoduleResource{}
+)
+
+// NewSubmoduleResource is a helper function to simplify the provider implementation.
+func NewSubmoduleResource() resource.Resource {
+	return &submoduleResource{}
+}
+
+// submoduleResource is the resource implementation.
+type submoduleResource struct {
+	data *gitlocalProviderData
+}
+
+// submoduleResourceModel maps the resource schema data.
+type submoduleResourceModel struct {
+	Branch         types.String `tfsdk:"branch"`
+	Commit         types.String `tfsdk:"commit"`
+	CurrentCommit  types.String `tfsdk:"current_commit"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Path           types.String `tfsdk:"path"`
+	RepositoryPath types.String `tfsdk:"repository_path"`
+	UpdateCheckout types.Bool   `tfsdk:"update_checkout"`
+	URL            types.String `tfsdk:"url"`
+}
+
+// Metadata returns the resource type name.
+func (r *submoduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_submodule"
+}
+
+// Schema defines the schema for the resource.
+func (r *submoduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Declares a submodule in `.gitmodules` and pins the commit recorded for it in the index. " +
+			"Changes are staged but not committed. Destroying the resource unregisters the submodule and leaves its checkout on disk.",
+		Attributes: map[string]schema.Attribute{
+			"branch": schema.StringAttribute{
+				Description: "Branch tracked by the submodule",
+				Optional:    true,
+			},
+			"commit": schema.StringAttribute{
+				Description: "Hash of the commit to record for the submodule in the superproject",
+				Required:    true,
+			},
+			"current_commit": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the commit checked out in the submodule. Null when the submodule is not initialized",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the submodule",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the submodule. Defaults to `path`",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the submodule, relative to the root of the worktree. No file may be tracked at or under it",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository_path": schema.StringAttribute{
+				Description: "Path to the local git repository containing the submodule. Defaults to the provider `path`",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"update_checkout": schema.BoolAttribute{
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to initialize the submodule and check out `commit`, fetching it from `url` when needed. Defaults to `false`",
+				Optional:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL of the submodule repository",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the commit hash.
+func (r *submoduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config submoduleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Commit.IsNull() && !config.Commit.IsUnknown() && !plumbing.IsHash(config.Commit.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("commit"),
+			"Invalid Commit Hash",
+			"The commit must be a full hexadecimal commit hash, got `"+config.Commit.ValueString()+"`.",
+		)
+	}
+}
+
+// Create registers the submodule and sets the initial Terraform state.
+func (r *submoduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan submoduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Name.IsUnknown() {
+		plan.Name = plan.Path
+	}
+
+	repo, worktree, diags := r.worktree(plan.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, diags := r.lock(repo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
+	gitmodules, err := readGitmodulesConfig(worktree)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read .gitmodules",
+			err.Error(),
+		)
+		return
+	}
+
+	if gitmodules.Section("submodule").HasSubsection(plan.Name.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Submodule Already Exists",
+			"The submodule `"+plan.Name.ValueString()+"` is already declared in .gitmodules. Import it to manage it with Terraform.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, repo, worktree, gitmodules, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *submoduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state submoduleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, worktree, diags := r.worktree(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gitmodules, err := readGitmodulesConfig(worktree)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read .gitmodules",
+			err.Error(),
+		)
+		return
+	}
+
+	name := state.Name.ValueString()
+
+	section := gitmodules.Section("submodule")
+	if !section.HasSubsection(name) {
+		// The submodule was removed outside of Terraform.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	subsection := section.Subsection(name)
+
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Index",
+			err.Error(),
+		)
+		return
+	}
+
+	submoduleState, err := readSubmodule(worktree, idx, &config.Submodule{
+		Name:   name,
+		Path:   subsection.Option("path"),
+		URL:    subsection.Option("url"),
+		Branch: subsection.Option("branch"),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Submodule `"+name+"`",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Branch = submoduleState.Branch
+	state.Commit = submoduleState.Commit
+	state.CurrentCommit = submoduleState.CurrentCommit
+	state.ID = submoduleState.Name
+	state.Path = submoduleState.Path
+	state.URL = submoduleState.URL
+
+	if state.UpdateCheckout.IsNull() {
+		state.UpdateCheckout = types.BoolValue(false)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the submodule and sets the updated Terraform state on success.
+func (r *submoduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan submoduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, worktree, diags := r.worktree(plan.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, diags := r.lock(repo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
+	gitmodules, err := readGitmodulesConfig(worktree)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read .gitmodules",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, repo, worktree, gitmodules, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete unregisters the submodule from .gitmodules, the index and the
+// repository configuration.
+func (r *submoduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state submoduleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repo, worktree, diags := r.worktree(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock, diags := r.lock(repo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer unlock()
+
+	name := state.Name.ValueString()
+
+	gitmodules, err := readGitmodulesConfig(worktree)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read .gitmodules",
+			err.Error(),
+		)
+		return
+	}
+
+	section := gitmodules.Section("submodule")
+	section.RemoveSubsection(name)
+
+	if len(section.Subsections) == 0 {
+		_, err = worktree.Remove(".gitmodules")
+	} else {
+		err = writeGitmodulesConfig(worktree, gitmodules)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Write .gitmodules",
+			err.Error(),
+		)
+		return
+	}
+
+	idx, err := repo.Storer.Index()
+	if err == nil {
+		_, err = idx.Remove(state.Path.ValueString())
+		if errors.Is(err, index.ErrEntryNotFound) {
+			err = nil
+		}
+	}
+	if err == nil {
+		err = repo.Storer.SetIndex(idx)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Git Index",
+			err.Error(),
+		)
+		return
+	}
+
+	repoConfig, err := repo.Config()
+	if err == nil {
+		delete(repoConfig.Submodules, name)
+		err = repo.SetConfig(repoConfig)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Git Config",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a submodule by name.
+func (r *submoduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *submoduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.data = data
+}
+
+// worktree opens the superproject and its worktree.
+func (r *submoduleResource) worktree(repositoryPath types.String) (*git.Repository, *git.Worktree, diag.Diagnostics) {
+	repo, diags := r.data.Repository(repositoryPath)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	worktree, worktreeDiags := repositoryWorktree(repo, "gitlocal_submodule")
+	diags.Append(worktreeDiags...)
+
+	return repo, worktree, diags
+}
+
+// lock locks the superproject against changes by other resources until the
+// returned function is called.
+func (r *submoduleResource) lock(repo *git.Repository) (func(), diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	unlock, err := r.data.Lock(repo)
+	if err != nil {
+		diags.AddError(
+			"Unable to Lock Git Repository",
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	return unlock, diags
+}
+
+// write declares the submodule in .gitmodules, records its commit in the
+// index, and checks it out when requested. The computed attributes of plan are
+// set from the result. The caller must hold the lock of the repository from
+// reading gitmodules onwards.
+func (r *submoduleResource) write(ctx context.Context, repo *git.Repository, worktree *git.Worktree, gitmodules *format.Config, plan *submoduleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	name := plan.Name.ValueString()
+	submodulePath := plan.Path.ValueString()
+	url := plan.URL.ValueString()
+	commit := plumbing.NewHash(plan.Commit.ValueString())
+
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Git Index",
+			err.Error(),
+		)
+		return diags
+	}
+
+	// The path must hold either nothing or the gitlink of the submodule, so
+	// that no tracked file is replaced.
+	entry, err := idx.Entry(submodulePath)
+	switch {
+	case errors.Is(err, index.ErrEntryNotFound):
+	case err != nil:
+		diags.AddError(
+			"Unable to Read Git Index",
+			err.Error(),
+		)
+		return diags
+	case entry.Mode != filemode.Submodule:
+		diags.AddAttributeError(
+			path.Root("path"),
+			"Path Already Tracked",
+			"The file `"+submodulePath+"` is tracked in the index, so it cannot hold a submodule.",
+		)
+		return diags
+	}
+
+	for _, e := range idx.Entries {
+		if strings.HasPrefix(e.Name, submodulePath+"/") {
+			diags.AddAttributeError(
+				path.Root("path"),
+				"Path Already Tracked",
+				"The file `"+e.Name+"` is tracked in the index under `"+submodulePath+"`, so it cannot hold a submodule.",
+			)
+			return diags
+		}
+	}
+
+	subsection := gitmodules.Section("submodule").Subsection(name)
+	subsection.SetOption("path", submodulePath)
+	subsection.SetOption("url", url)
+
+	if plan.Branch.IsNull() {
+		subsection.RemoveOption("branch")
+	} else {
+		subsection.SetOption("branch", plan.Branch.ValueString())
+	}
+
+	err = writeGitmodulesConfig(worktree, gitmodules)
+	if err == nil {
+		_, err = worktree.Add(".gitmodules")
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to Write .gitmodules",
+			err.Error(),
+		)
+		return diags
+	}
+
+	// Staging .gitmodules updated the index, so it is read again.
+	idx, err = repo.Storer.Index()
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Git Index",
+			err.Error(),
+		)
+		return diags
+	}
+
+	entry, err = idx.Entry(submodulePath)
+	if errors.Is(err, index.ErrEntryNotFound) {
+		entry = idx.Add(submodulePath)
+	} else if err != nil {
+		diags.AddError(
+			"Unable to Read Git Index",
+			err.Error(),
+		)
+		return diags
+	}
+
+	entry.Hash = commit
+	entry.Mode = filemode.Submodule
+
+	if err := repo.Storer.SetIndex(idx); err != nil {
+		diags.AddError(
+			"Unable to Update Git Index",
+			err.Error(),
+		)
+		return diags
+	}
+
+	// Keep the URL of an initialized submodule in sync with .gitmodules, as
+	// `git submodule sync` would.
+	repoConfig, err := repo.Config()
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Git Config",
+			err.Error(),
+		)
+		return diags
+	}
+
+	if initialized, ok := repoConfig.Submodules[name]; ok && initialized.URL != url {
+		initialized.URL = url
+
+		if err := repo.SetConfig(repoConfig); err != nil {
+			diags.AddError(
+				"Unable to Update Git Config",
+				err.Error(),
+			)
+			return diags
+		}
+
+		if err := setSubmoduleRemoteURL(worktree, name, url); err != nil {
+			diags.AddError(
+				"Unable to Update Git Submodule `"+name+"` Remote",
+				err.Error(),
+			)
+			return diags
+		}
+	}
+
+	if plan.UpdateCheckout.ValueBool() {
+		submodule, err := worktree.Submodule(name)
+		if err == nil {
+			err = submodule.UpdateContext(ctx, &git.SubmoduleUpdateOptions{Init: true})
+		}
+		if err != nil {
+			diags.AddError(
+				"Unable to Check Out Git Submodule `"+name+"`",
+				err.Error(),
+			)
+			return diags
+		}
+	}
+
+	idx, err = repo.Storer.Index()
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Git Index",
+			err.Error(),
+		)
+		return diags
+	}
+
+	submoduleState, err := readSubmodule(worktree, idx, &config.Submodule{Name: name, Path: submodulePath, URL: url})
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Git Submodule `"+name+"`",
+			err.Error(),
+		)
+		return diags
+	}
+
+	plan.CurrentCommit = submoduleState.CurrentCommit
+	plan.ID = plan.Name
+
+	return diags
+}
+
+// readGitmodulesConfig reads the .gitmodules file at the root of the worktree
+// as a raw git config, so that it can be written back with its ordering and
+// unknown options preserved.
+func readGitmodulesConfig(worktree *git.Worktree) (*format.Config, error) {
+	gitmodules := format.New()
+
+	file, err := worktree.Filesystem.Open(".gitmodules")
+	if errors.Is(err, os.ErrNotExist) {
+		return gitmodules, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := format.NewDecoder(bytes.NewReader(content)).Decode(gitmodules); err != nil {
+		return nil, err
+	}
+
+	return gitmodules, nil
+}
+
+// writeGitmodulesConfig writes the .gitmodules file at the root of the worktree.
+func writeGitmodulesConfig(worktree *git.Worktree, gitmodules *format.Config) error {
+	var buf bytes.Buffer
+	if err := format.NewEncoder(&buf).Encode(gitmodules); err != nil {
+		return err
+	}
+
+	file, err := worktree.Filesystem.Create(".gitmodules")
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(buf.Bytes()); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// setSubmoduleRemoteURL points the origin remote of an initialized submodule
+// at url.
+func setSubmoduleRemoteURL(worktree *git.Worktree, name string, url string) error {
+	submodule, err := worktree.Submodule(name)
+	if err != nil {
+		return err
+	}
+
+	submoduleRepo, err := submodule.Repository()
+	if err != nil {
+		return err
+	}
+
+	submoduleConfig, err := submoduleRepo.Config()
+	if err != nil {
+		return err
+	}
+
+	remote, ok := submoduleConfig.Remotes[git.DefaultRemoteName]
+	if !ok {
+		return nil
+	}
+
+	remote.URLs = []string{url}
+
+	return submoduleRepo.SetConfig(submoduleConfig)
+}
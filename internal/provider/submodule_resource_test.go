@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSubmoduleResource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	app := testAccRepositoryFixture(t, "v1", "v2")
+	commits := testAccCommitHashes(t, app)
+
+	config := func(commit string) string {
+		return fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+resource "gitlocal_submodule" "test" {
+  path            = "apps/app"
+  url             = %q
+  commit          = %q
+  update_checkout = true
+}
+`, fixture, app, commit)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(commits[0]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlocal_submodule.test", "id", "apps/app"),
+					resource.TestCheckResourceAttr("gitlocal_submodule.test", "name", "apps/app"),
+					resource.TestCheckResourceAttr("gitlocal_submodule.test", "commit", commits[0]),
+					resource.TestCheckResourceAttr("gitlocal_submodule.test", "current_commit", commits[0]),
+				),
+			},
+			{
+				Config: config(commits[1]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlocal_submodule.test", "commit", commits[1]),
+					resource.TestCheckResourceAttr("gitlocal_submodule.test", "current_commit", commits[1]),
+				),
+			},
+			{
+				ResourceName:                         "gitlocal_submodule.test",
+				ImportState:                          true,
+				ImportStateId:                        "apps/app",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"update_checkout"},
+			},
+		},
+	})
+}
+
+func TestSubmoduleResourceTrackedPath(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	app := testAccRepositoryFixture(t, "v1")
+	commits := testAccCommitHashes(t, app)
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitFile(t, worktree, "apps/app/main.go", "package main\n")
+
+	config := func(submodulePath string) string {
+		return fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+resource "gitlocal_submodule" "test" {
+  path   = %q
+  url    = %q
+  commit = %q
+}
+`, fixture, submodulePath, app, commits[0])
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("README.md"),
+				ExpectError: regexp.MustCompile("Path Already Tracked"),
+			},
+			{
+				Config:      config("apps/app"),
+				ExpectError: regexp.MustCompile("Path Already Tracked"),
+			},
+		},
+	})
+}
+
+func TestSubmoduleResourceMultiple(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	app := testAccRepositoryFixture(t, "v1")
+	lib := testAccRepositoryFixture(t, "v1")
+	appCommits := testAccCommitHashes(t, app)
+	libCommits := testAccCommitHashes(t, lib)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+resource "gitlocal_submodule" "app" {
+  path   = "apps/app"
+  url    = %q
+  commit = %q
+}
+
+resource "gitlocal_submodule" "lib" {
+  path   = "libs/lib"
+  url    = %q
+  commit = %q
+}
+
+data "gitlocal_submodules" "test" {
+  depends_on = [gitlocal_submodule.app, gitlocal_submodule.lib]
+}
+`, fixture, app, appCommits[0], lib, libCommits[0]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.0.path", "apps/app"),
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.0.commit", appCommits[0]),
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.1.path", "libs/lib"),
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.1.commit", libCommits[0]),
+				),
+			},
+		},
+	})
+}
+
+func TestSubmoduleResourceDrift(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	app := testAccRepositoryFixture(t, "v1", "v2")
+	other := testAccRepositoryFixture(t, "v1")
+	commits := testAccCommitHashes(t, app)
+
+	config := fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+resource "gitlocal_submodule" "test" {
+  path   = "apps/app"
+  url    = %q
+  commit = %q
+}
+`, fixture, app, commits[0])
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				// Change the URL and the gitlink outside of Terraform.
+				PreConfig: func() {
+					repo, err := git.PlainOpen(fixture)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					worktree, err := repo.Worktree()
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					gitmodules, err := readGitmodulesConfig(worktree)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					gitmodules.Section("submodule").Subsection("apps/app").SetOption("url", other)
+
+					if err := writeGitmodulesConfig(worktree, gitmodules); err != nil {
+						t.Fatal(err)
+					}
+
+					idx, err := repo.Storer.Index()
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					entry, err := idx.Entry("apps/app")
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					entry.Hash = plumbing.NewHash(commits[1])
+
+					if err := repo.Storer.SetIndex(idx); err != nil {
+						t.Fatal(err)
+					}
+				},
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlocal_submodule.test", "url", other),
+					resource.TestCheckResourceAttr("gitlocal_submodule.test", "commit", commits[1]),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlocal_submodule.test", "url", app),
+					resource.TestCheckResourceAttr("gitlocal_submodule.test", "commit", commits[0]),
+				),
+			},
+		},
+	})
+}
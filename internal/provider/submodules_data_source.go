@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &submodulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &submodulesDataSource{}
+)
+
+// NewSubmodulesDataSource is a helper function to simplify the provider implementation.
+func NewSubmodulesDataSource() datasource.DataSource {
+	return &submodulesDataSource{}
+}
+
+// submodulesDataSource is the data source implementation.
+type submodulesDataSource struct {
+	data *gitlocalProviderData
+}
+
+// submodulesDataSourceModel maps the data source schema data.
+type submodulesDataSourceModel struct {
+	RepositoryPath types.String      `tfsdk:"repository_path"`
+	Submodules     []submodulesModel `tfsdk:"submodules"`
+}
+
+// submodulesModel maps submodule schema data.
+type submodulesModel struct {
+	Branch        types.String `tfsdk:"branch"`
+	Commit        types.String `tfsdk:"commit"`
+	CurrentCommit types.String `tfsdk:"current_commit"`
+	Initialized   types.Bool   `tfsdk:"initialized"`
+	Name          types.String `tfsdk:"name"`
+	Path          types.String `tfsdk:"path"`
+	URL           types.String `tfsdk:"url"`
+}
+
+// Metadata returns the data source type name.
+func (d *submodulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_submodules"
+}
+
+// Schema defines the schema for the data source.
+func (d *submodulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the submodules declared in `.gitmodules`, with the commit recorded for each in the index. Requires a repository with a worktree.",
+		Attributes: map[string]schema.Attribute{
+			"repository_path": RepositoryPathAttribute(),
+			"submodules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of submodules, ordered by path",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"branch": schema.StringAttribute{
+							Computed:    true,
+							Description: "Branch tracked by the submodule. Null when not set in `.gitmodules`",
+						},
+						"commit": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash of the commit recorded for the submodule in the superproject. Null when the path is not staged as a submodule",
+						},
+						"current_commit": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash of the commit checked out in the submodule. Null when the submodule is not initialized",
+						},
+						"initialized": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the submodule repository is checked out at its path",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the submodule",
+						},
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Path of the submodule, relative to the root of the worktree",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL of the submodule repository",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *submodulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state submodulesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	worktree, diags := repositoryWorktree(repo, "gitlocal_submodules")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modules, err := readGitmodules(worktree)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read .gitmodules",
+			err.Error(),
+		)
+		return
+	}
+
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Index",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Submodules = nil
+
+	for _, submodule := range sortedSubmodules(modules) {
+		submoduleState, err := readSubmodule(worktree, idx, submodule)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Git Submodule `"+submodule.Name+"`",
+				err.Error(),
+			)
+			return
+		}
+
+		state.Submodules = append(state.Submodules, submoduleState)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *submodulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// readGitmodules parses the .gitmodules file at the root of the worktree. An
+// empty set of modules is returned when the file does not exist.
+func readGitmodules(worktree *git.Worktree) (*config.Modules, error) {
+	modules := config.NewModules()
+
+	file, err := worktree.Filesystem.Open(".gitmodules")
+	if errors.Is(err, os.ErrNotExist) {
+		return modules, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := modules.Unmarshal(content); err != nil {
+		return nil, err
+	}
+
+	return modules, nil
+}
+
+// sortedSubmodules returns the submodules ordered by path.
+func sortedSubmodules(modules *config.Modules) []*config.Submodule {
+	submodules := make([]*config.Submodule, 0, len(modules.Submodules))
+	for _, submodule := range modules.Submodules {
+		submodules = append(submodules, submodule)
+	}
+
+	sort.Slice(submodules, func(i, j int) bool {
+		return submodules[i].Path < submodules[j].Path
+	})
+
+	return submodules
+}
+
+// readSubmodule maps a submodule with the gitlink recorded in the index and
+// the commit checked out at its path.
+func readSubmodule(worktree *git.Worktree, idx *index.Index, submodule *config.Submodule) (submodulesModel, error) {
+	submoduleState := submodulesModel{
+		Branch:        types.StringNull(),
+		Commit:        types.StringNull(),
+		CurrentCommit: types.StringNull(),
+		Initialized:   types.BoolValue(false),
+		Name:          types.StringValue(submodule.Name),
+		Path:          types.StringValue(submodule.Path),
+		URL:           types.StringValue(submodule.URL),
+	}
+
+	if submodule.Branch != "" {
+		submoduleState.Branch = types.StringValue(submodule.Branch)
+	}
+
+	entry, err := idx.Entry(submodule.Path)
+	if err != nil && !errors.Is(err, index.ErrEntryNotFound) {
+		return submoduleState, err
+	}
+	if entry != nil && entry.Mode == filemode.Submodule {
+		submoduleState.Commit = types.StringValue(entry.Hash.String())
+	}
+
+	// Open the submodule on its own, rather than through go-git's
+	// Submodule.Repository, which initializes missing submodules.
+	submoduleRepo, err := git.PlainOpen(filepath.Join(worktree.Filesystem.Root(), submodule.Path))
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		return submoduleState, nil
+	}
+	if err != nil {
+		return submoduleState, err
+	}
+
+	submoduleState.Initialized = types.BoolValue(true)
+
+	head, err := submoduleRepo.Head()
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return submoduleState, nil
+	}
+	if err != nil {
+		return submoduleState, err
+	}
+
+	submoduleState.CurrentCommit = types.StringValue(head.Hash().String())
+
+	return submoduleState, nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSubmodulesDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	app := testAccRepositoryFixture(t, "app")
+	lib := testAccRepositoryFixture(t, "lib")
+
+	appCommit := testAccSubmoduleFixture(t, fixture, "apps/app", app, true)
+	libCommit := testAccSubmoduleFixture(t, fixture, "lib", lib, false)
+
+	bare := filepath.Join(t.TempDir(), "bare.git")
+	if _, err := git.PlainClone(bare, true, &git.CloneOptions{URL: fixture}); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_submodules" "test" { }
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.#", "2"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.0.name", "apps/app"),
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.0.path", "apps/app"),
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.0.url", app),
+					resource.TestCheckNoResourceAttr("data.gitlocal_submodules.test", "submodules.0.branch"),
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.0.commit", appCommit.String()),
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.0.current_commit", appCommit.String()),
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.0.initialized", "true"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.1.name", "lib"),
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.1.commit", libCommit.String()),
+					resource.TestCheckNoResourceAttr("data.gitlocal_submodules.test", "submodules.1.current_commit"),
+					resource.TestCheckResourceAttr("data.gitlocal_submodules.test", "submodules.1.initialized", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_submodules" "test" { }
+`, bare),
+				ExpectError: regexp.MustCompile("Repository Has No Worktree"),
+			},
+		},
+	})
+}
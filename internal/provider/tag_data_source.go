@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &tagDataSource{}
+	_ datasource.DataSourceWithConfigure      = &tagDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &tagDataSource{}
+)
+
+// NewTagDataSource is a helper function to simplify the provider implementation.
+func NewTagDataSource() datasource.DataSource {
+	return &tagDataSource{}
+}
+
+// tagDataSource is the data source implementation.
+type tagDataSource struct {
+	data *gitlocalProviderData
+}
+
+// tagDataSourceModel maps the data source schema data.
+type tagDataSourceModel struct {
+	Annotated      types.Bool   `tfsdk:"annotated"`
+	Commit         types.String `tfsdk:"commit"`
+	Date           types.String `tfsdk:"date"`
+	Hash           types.String `tfsdk:"hash"`
+	Message        types.String `tfsdk:"message"`
+	Name           types.String `tfsdk:"name"`
+	RepositoryPath types.String `tfsdk:"repository_path"`
+	TaggerEmail    types.String `tfsdk:"tagger_email"`
+	TaggerName     types.String `tfsdk:"tagger_name"`
+
+	signatureModel
+}
+
+// Metadata returns the data source type name.
+func (d *tagDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+// Schema defines the schema for the data source.
+func (d *tagDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := SignatureAttributes()
+	attributes["annotated"] = schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether the tag is an annotated tag object rather than a lightweight tag",
+	}
+	attributes["commit"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Hash of the commit the tag points to, following nested tags",
+	}
+	attributes["date"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Date the tag was created in RFC 3339. Null for lightweight tags",
+	}
+	attributes["hash"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Hash the tag reference points to, which is the tag object for annotated tags and the commit for lightweight tags",
+	}
+	attributes["message"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Message of the tag. Null for lightweight tags",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "Name of the tag, either short such as `v1.0.0` or full such as `refs/tags/v1.0.0`",
+		Required:    true,
+	}
+	attributes["repository_path"] = RepositoryPathAttribute()
+	attributes["tagger_email"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Email of the tagger. Null for lightweight tags",
+	}
+	attributes["tagger_name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the tagger. Null for lightweight tags",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reads a lightweight or annotated tag, and verifies the signature of annotated tags.",
+		Attributes:  attributes,
+	}
+}
+
+// ValidateConfig validates the trusted keys.
+func (d *tagDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config tagDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSignatureConfig(config.signatureModel)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *tagDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tagDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	if !strings.HasPrefix(name, "refs/") {
+		name = plumbing.NewTagReferenceName(name).String()
+	}
+
+	ref, err := repo.Reference(plumbing.ReferenceName(name), true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tag `"+state.Name.ValueString()+"`",
+			err.Error(),
+		)
+		return
+	}
+
+	keys, diags := readTrustedKeys(state.signatureModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Annotated = types.BoolValue(false)
+	state.Commit = types.StringValue(ref.Hash().String())
+	state.Date = types.StringNull()
+	state.Hash = types.StringValue(ref.Hash().String())
+	state.Message = types.StringNull()
+	state.TaggerEmail = types.StringNull()
+	state.TaggerName = types.StringNull()
+
+	verifySignature(&state.signatureModel, signedObject{}, keys)
+
+	tag, err := repo.TagObject(ref.Hash())
+	switch {
+	case errors.Is(err, plumbing.ErrObjectNotFound):
+		// Lightweight tags point directly at the commit.
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Unable to Read Tag `"+state.Name.ValueString()+"`",
+			err.Error(),
+		)
+		return
+	default:
+		commit, err := peelReference(repo, ref)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Peel Tag `"+state.Name.ValueString()+"`",
+				err.Error(),
+			)
+			return
+		}
+
+		state.Annotated = types.BoolValue(true)
+		state.Commit = types.StringValue(commit.String())
+		state.Date = types.StringValue(tag.Tagger.When.Format(time.RFC3339))
+		state.Message = types.StringValue(tag.Message)
+		state.TaggerEmail = types.StringValue(tag.Tagger.Email)
+		state.TaggerName = types.StringValue(tag.Tagger.Name)
+
+		verifySignature(&state.signatureModel, tagSignedObject(tag), keys)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *tagDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTagDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	commit := testAccCommitHashes(t, fixture)[0]
+
+	signer, signerKey := testAccPGPEntity(t, "Fixture Signer", "signer@example.com")
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.CreateTag("lightweight", plumbing.NewHash(commit), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	annotated, err := repo.CreateTag("v1.0.0", plumbing.NewHash(commit), &git.CreateTagOptions{
+		Tagger:  &testAccSignature,
+		Message: "Release 1.0.0",
+		SignKey: signer,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_tag" "lightweight" {
+  name = "lightweight"
+}
+
+data "gitlocal_tag" "annotated" {
+  name    = "refs/tags/v1.0.0"
+  keyring = %q
+}
+`, fixture, signerKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_tag.lightweight", "annotated", "false"),
+					resource.TestCheckResourceAttr("data.gitlocal_tag.lightweight", "hash", commit),
+					resource.TestCheckResourceAttr("data.gitlocal_tag.lightweight", "commit", commit),
+					resource.TestCheckNoResourceAttr("data.gitlocal_tag.lightweight", "message"),
+					resource.TestCheckNoResourceAttr("data.gitlocal_tag.lightweight", "signature"),
+					resource.TestCheckResourceAttr("data.gitlocal_tag.lightweight", "signature_verified", "false"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_tag.annotated", "annotated", "true"),
+					resource.TestCheckResourceAttr("data.gitlocal_tag.annotated", "hash", annotated.Hash().String()),
+					resource.TestCheckResourceAttr("data.gitlocal_tag.annotated", "commit", commit),
+					resource.TestCheckResourceAttr("data.gitlocal_tag.annotated", "message", "Release 1.0.0\n"),
+					resource.TestCheckResourceAttr("data.gitlocal_tag.annotated", "tagger_name", "Fixture Author"),
+					resource.TestCheckResourceAttr("data.gitlocal_tag.annotated", "tagger_email", "fixture@example.com"),
+					resource.TestCheckResourceAttr("data.gitlocal_tag.annotated", "date", "2025-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttrSet("data.gitlocal_tag.annotated", "signature"),
+					resource.TestCheckResourceAttr("data.gitlocal_tag.annotated", "signature_verified", "true"),
+					resource.TestCheckResourceAttr("data.gitlocal_tag.annotated", "signer_key_id", signer.PrimaryKey.KeyIdString()),
+					resource.TestCheckResourceAttr("data.gitlocal_tag.annotated", "signer_identity", "Fixture Signer <signer@example.com>"),
+				),
+			},
+		},
+	})
+}
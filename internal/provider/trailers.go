@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// coAuthorTrailer is the trailer crediting the co-authors of a commit.
+const coAuthorTrailer = "Co-authored-by"
+
+// trailerPattern matches the first line of a trailer, such as
+// `Co-authored-by: Jane Doe <jane@example.com>`.
+var trailerPattern = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*) *: *(.*?)\s*$`)
+
+// identityPattern matches an identity such as `Jane Doe <jane@example.com>`.
+var identityPattern = regexp.MustCompile(`^(.*?)\s*<([^<>]*)>$`)
+
+// trailersModel maps the trailer schema data shared by the data sources
+// describing commits.
+type trailersModel struct {
+	CoAuthors []coAuthorModel `tfsdk:"co_authors"`
+	Trailers  []trailerModel  `tfsdk:"trailers"`
+}
+
+// coAuthorModel maps co-author schema data.
+type coAuthorModel struct {
+	Email types.String `tfsdk:"email"`
+	Name  types.String `tfsdk:"name"`
+}
+
+// trailerModel maps trailer schema data.
+type trailerModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+// TrailerAttributes returns the attributes exposing the trailers of a commit
+// message.
+func TrailerAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"co_authors": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Co-authors credited with `Co-authored-by` trailers, in order",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"email": schema.StringAttribute{
+						Computed:    true,
+						Description: "Email of the co-author. Null when the trailer has none",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the co-author",
+					},
+				},
+			},
+		},
+		"trailers": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Trailers of the message, such as `Signed-off-by`, in order. Only the last paragraph is parsed, as `git interpret-trailers` does",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Computed:    true,
+						Description: "Key of the trailer, as written",
+					},
+					"value": schema.StringAttribute{
+						Computed:    true,
+						Description: "Value of the trailer, with continuation lines joined by a space",
+					},
+				},
+			},
+		},
+	}
+}
+
+// newTrailersModel returns the trailers and co-authors of message, recognizing
+// the configured trailer tokens.
+func newTrailersModel(message string, tokens []string) trailersModel {
+	model := trailersModel{
+		CoAuthors: []coAuthorModel{},
+		Trailers:  []trailerModel{},
+	}
+
+	for _, trailer := range parseTrailers(message, tokens) {
+		model.Trailers = append(model.Trailers, trailerModel{
+			Key:   types.StringValue(trailer.key),
+			Value: types.StringValue(trailer.value),
+		})
+
+		if !strings.EqualFold(trailer.key, coAuthorTrailer) {
+			continue
+		}
+
+		coAuthor := coAuthorModel{
+			Email: types.StringNull(),
+			Name:  types.StringValue(trailer.value),
+		}
+
+		if match := identityPattern.FindStringSubmatch(trailer.value); match != nil {
+			coAuthor.Name = types.StringValue(match[1])
+			if match[2] != "" {
+				coAuthor.Email = types.StringValue(match[2])
+			}
+		}
+
+		model.CoAuthors = append(model.CoAuthors, coAuthor)
+	}
+
+	return model
+}
+
+// commitTrailer is a trailer of a commit message.
+type commitTrailer struct {
+	key   string
+	value string
+}
+
+// gitGeneratedTrailerPrefixes are the prefixes of the lines git adds to commit
+// messages, which mark the paragraph holding them as trailers.
+var gitGeneratedTrailerPrefixes = []string{"Signed-off-by: ", "(cherry picked from commit "}
+
+// repositoryTrailerTokens returns the tokens and keys of the trailers set in
+// the trailer.<token> sections of the configuration of repo, which git
+// recognizes like the trailers it generates.
+func repositoryTrailerTokens(repo *git.Repository) ([]string, error) {
+	configs, err := repositoryConfigs(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []string
+
+	for _, cfg := range configs {
+		for _, subsection := range cfg.Raw.Section("trailer").Subsections {
+			tokens = append(tokens, subsection.Name)
+
+			if key := strings.TrimRight(subsection.Option("key"), ": "); key != "" {
+				tokens = append(tokens, key)
+			}
+		}
+	}
+
+	return tokens, nil
+}
+
+// parseTrailers returns the trailers of message, as `git interpret-trailers
+// --parse` does. They are found in the last paragraph after the subject, when
+// all of its lines are trailers or continuations of one, or when at least a
+// quarter of them are and one is generated by git, such as `Signed-off-by`, or
+// configured with a trailer.<token> section, whose tokens are given. Other
+// lines of the paragraph are left out.
+func parseTrailers(message string, tokens []string) []commitTrailer {
+	lines := strings.Split(strings.ReplaceAll(message, "\r\n", "\n"), "\n")
+
+	isBlank := func(line string) bool {
+		return strings.TrimSpace(line) == ""
+	}
+
+	// The subject runs until the first blank line.
+	start := slices.IndexFunc(lines, isBlank)
+	if start < 0 {
+		return nil
+	}
+
+	end := len(lines)
+	for end > start && isBlank(lines[end-1]) {
+		end--
+	}
+
+	trailerLines, nonTrailerLines, continuationLines := 0, 0, 0
+	recognized := false
+
+	i := end - 1
+	for ; i > start && !isBlank(lines[i]); i-- {
+		line := lines[i]
+
+		switch {
+		case strings.HasPrefix(line, "#"):
+			// Comments are neither trailers nor other lines.
+		case strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t"):
+			continuationLines++
+		case slices.ContainsFunc(gitGeneratedTrailerPrefixes, func(prefix string) bool { return strings.HasPrefix(line, prefix) }):
+			trailerLines++
+			continuationLines = 0
+			recognized = true
+		case trailerPattern.MatchString(line):
+			key := trailerPattern.FindStringSubmatch(line)[1]
+			trailerLines++
+			continuationLines = 0
+			recognized = recognized || slices.ContainsFunc(tokens, func(token string) bool { return strings.EqualFold(token, key) })
+		default:
+			nonTrailerLines += continuationLines + 1
+			continuationLines = 0
+		}
+	}
+
+	if trailerLines == 0 || (nonTrailerLines > 0 && (!recognized || trailerLines*3 < nonTrailerLines)) {
+		return nil
+	}
+
+	var trailers []commitTrailer
+
+	// last is the index of the trailer continuation lines are added to, or -1
+	// when the previous line is not a trailer.
+	last := -1
+
+	for _, line := range lines[i+1 : end] {
+		switch {
+		case strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t"):
+			if last >= 0 {
+				trailers[last].value += " " + strings.TrimSpace(line)
+			}
+		default:
+			last = -1
+
+			if match := trailerPattern.FindStringSubmatch(line); match != nil {
+				trailers = append(trailers, commitTrailer{key: match[1], value: match[2]})
+				last = len(trailers) - 1
+			}
+		}
+	}
+
+	return trailers
+}
+
+// trailerValue returns the value of the last trailer named key, compared
+// case-insensitively, and false when there is none.
+func trailerValue(trailers []commitTrailer, key string) (string, bool) {
+	for i := len(trailers) - 1; i >= 0; i-- {
+		if strings.EqualFold(trailers[i].key, key) {
+			return trailers[i].value, true
+		}
+	}
+
+	return "", false
+}
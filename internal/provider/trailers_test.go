@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTrailers(t *testing.T) {
+	cases := []struct {
+		message string
+		want    []commitTrailer
+	}{
+		{"Subject only\n", nil},
+		{"Subject: not a trailer\n", nil},
+		{"Subject\n\nBody paragraph.\n", nil},
+		{
+			"Subject\n\nBody.\n\nSigned-off-by: Jane Doe <jane@example.com>\nChangelog-Category: Added\n",
+			[]commitTrailer{{"Signed-off-by", "Jane Doe <jane@example.com>"}, {"Changelog-Category", "Added"}},
+		},
+		{
+			"Subject\r\n\r\nFixes: #12\r\n",
+			[]commitTrailer{{"Fixes", "#12"}},
+		},
+		{
+			"Subject\n\nNote: a long\n  value on two lines\n",
+			[]commitTrailer{{"Note", "a long value on two lines"}},
+		},
+		{"Subject\n\nFixes: #12\nand something else\n", nil},
+		{
+			"Subject\n\n\nBody.\n\n\n\nFixes: #12\n \nReviewed-by: Jane Doe <jane@example.com>\n\n\n",
+			[]commitTrailer{{"Reviewed-by", "Jane Doe <jane@example.com>"}},
+		},
+		{
+			"Subject\n\nBody.\n\nReviewed-by: Jane Doe <jane@example.com>\n(cherry picked from commit 0123456789abcdef0123456789abcdef01234567)\n",
+			[]commitTrailer{{"Reviewed-by", "Jane Doe <jane@example.com>"}},
+		},
+		{
+			"Subject\n\nSigned-off-by: Jane Doe <jane@example.com>\nFixes: #12\n  and #13\nSee the issue for details.\n",
+			[]commitTrailer{{"Signed-off-by", "Jane Doe <jane@example.com>"}, {"Fixes", "#12 and #13"}},
+		},
+		{"Subject\n\nSigned-off-by: Jane Doe <jane@example.com>\none\ntwo\nthree\nfour\n", nil},
+	}
+
+	for _, c := range cases {
+		if got := parseTrailers(c.message, nil); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("parseTrailers(%q) = %q, want %q", c.message, got, c.want)
+		}
+	}
+}
+
+func TestParseTrailersConfigured(t *testing.T) {
+	message := "Subject\n\nChange-Id: I0123456789\nUploaded from a laptop.\n"
+
+	if got := parseTrailers(message, nil); got != nil {
+		t.Errorf("parseTrailers(%q, nil) = %q, want nil", message, got)
+	}
+
+	want := []commitTrailer{{"Change-Id", "I0123456789"}}
+	if got := parseTrailers(message, []string{"change-id"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseTrailers(%q, [change-id]) = %q, want %q", message, got, want)
+	}
+}
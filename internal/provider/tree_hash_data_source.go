@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	pathpkg "path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &treeHashDataSource{}
+	_ datasource.DataSourceWithConfigure = &treeHashDataSource{}
+)
+
+// NewTreeHashDataSource is a helper function to simplify the provider implementation.
+func NewTreeHashDataSource() datasource.DataSource {
+	return &treeHashDataSource{}
+}
+
+// treeHashDataSource is the data source implementation.
+type treeHashDataSource struct {
+	data *gitlocalProviderData
+}
+
+// treeHashDataSourceModel maps the data source schema data.
+type treeHashDataSourceModel struct {
+	Hash            types.String `tfsdk:"hash"`
+	IncludeWorktree types.Bool   `tfsdk:"include_worktree"`
+	Path            types.String `tfsdk:"path"`
+	RepositoryPath  types.String `tfsdk:"repository_path"`
+	Revision        types.String `tfsdk:"revision"`
+	WorktreeHash    types.String `tfsdk:"worktree_hash"`
+}
+
+// Metadata returns the data source type name.
+func (d *treeHashDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tree_hash"
+}
+
+// Schema defines the schema for the data source.
+func (d *treeHashDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Computes the git tree hash of a directory, which only changes when the content of the directory changes. " +
+			"Useful as a deterministic trigger for resources built from a directory.",
+		Attributes: map[string]schema.Attribute{
+			"hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the tree of the directory at the revision",
+			},
+			"include_worktree": schema.BoolAttribute{
+				Description: "Whether to also compute `worktree_hash` from the files in the worktree. Defaults to `false`",
+				Optional:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the directory, relative to the root of the repository. Defaults to the root of the repository",
+				Optional:    true,
+			},
+			"repository_path": RepositoryPathAttribute(),
+			"revision":        RevisionAttribute(),
+			"worktree_hash": schema.StringAttribute{
+				Computed: true,
+				Description: "Hash of the tree `git add --all` would record for the directory, including uncommitted changes and untracked files that are not ignored. " +
+					"Files are hashed as they are on disk, without applying attributes such as line ending conversion. " +
+					"The empty tree hash when the directory was removed from the worktree. Null unless `include_worktree` is `true`",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *treeHashDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state treeHashDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commit, diags := resolveCommit(repo, state.Revision)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dir := pathpkg.Clean("/" + state.Path.ValueString())[1:]
+
+	tree, err := commit.Tree()
+	if err == nil && dir != "" {
+		tree, err = tree.Tree(dir)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Unable to Read Tree `"+dir+"`",
+			"The directory could not be read at `"+commit.Hash.String()+"`: "+err.Error(),
+		)
+		return
+	}
+
+	state.Hash = types.StringValue(tree.Hash.String())
+	state.WorktreeHash = types.StringNull()
+
+	if state.IncludeWorktree.ValueBool() {
+		worktree, diags := repositoryWorktree(repo, "gitlocal_tree_hash")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		hash, err := hashWorktreeDirectory(repo, worktree, dir)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Hash Worktree Directory `"+dir+"`",
+				err.Error(),
+			)
+			return
+		}
+
+		state.WorktreeHash = types.StringValue(hash.String())
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *treeHashDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// worktreeHasher computes tree hashes from the files of a worktree, skipping
+// the ones git ignores unless they are tracked.
+type worktreeHasher struct {
+	root    string
+	ignore  gitignore.Matcher
+	tracked map[string]*index.Entry
+	dirs    map[string]bool
+}
+
+// hashWorktreeDirectory returns the hash of the tree git would record for dir,
+// a slash separated path relative to the root of the worktree.
+func hashWorktreeDirectory(repo *git.Repository, worktree *git.Worktree, dir string) (plumbing.Hash, error) {
+	ignore, err := worktreeIgnoreMatcher(repo, worktree)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	hasher := worktreeHasher{
+		root:    worktree.Filesystem.Root(),
+		ignore:  ignore,
+		tracked: map[string]*index.Entry{},
+		dirs:    map[string]bool{},
+	}
+
+	for _, entry := range idx.Entries {
+		hasher.tracked[entry.Name] = entry
+
+		for parent := pathpkg.Dir(entry.Name); parent != "."; parent = pathpkg.Dir(parent) {
+			hasher.dirs[parent] = true
+		}
+	}
+
+	hash, _, err := hasher.hashDirectory(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return hasher.encodeTree(nil)
+	}
+
+	return hash, err
+}
+
+// hashDirectory returns the tree hash of dir, and false when it holds no file
+// git would record.
+func (h worktreeHasher) hashDirectory(dir string) (plumbing.Hash, bool, error) {
+	entries, err := os.ReadDir(filepath.Join(h.root, filepath.FromSlash(dir)))
+	if err != nil {
+		return plumbing.ZeroHash, false, err
+	}
+
+	var treeEntries []object.TreeEntry
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if name == git.GitDirName {
+			continue
+		}
+
+		rel := pathpkg.Join(dir, name)
+		full := filepath.Join(h.root, filepath.FromSlash(rel))
+
+		info, err := os.Lstat(full)
+		if err != nil {
+			return plumbing.ZeroHash, false, err
+		}
+
+		isDir := info.IsDir()
+
+		if h.ignore.Match(strings.Split(rel, "/"), isDir) && !h.isTracked(rel, isDir) {
+			continue
+		}
+
+		treeEntry := object.TreeEntry{Name: name}
+
+		switch {
+		case info.Mode()&os.ModeSymlink != 0:
+			target, err := os.Readlink(full)
+			if err != nil {
+				return plumbing.ZeroHash, false, err
+			}
+
+			treeEntry.Mode = filemode.Symlink
+			treeEntry.Hash = plumbing.ComputeHash(plumbing.BlobObject, []byte(filepath.ToSlash(target)))
+		case isDir:
+			if _, err := os.Stat(filepath.Join(full, git.GitDirName)); err == nil {
+				hash, ok := h.nestedRepositoryHead(rel, full)
+				if !ok {
+					continue
+				}
+
+				treeEntry.Mode = filemode.Submodule
+				treeEntry.Hash = hash
+				break
+			}
+
+			// A submodule that is not initialized is an empty directory, for
+			// which git records the commit from the index.
+			if tracked, ok := h.tracked[rel]; ok && tracked.Mode == filemode.Submodule {
+				treeEntry.Mode = filemode.Submodule
+				treeEntry.Hash = tracked.Hash
+				break
+			}
+
+			hash, ok, err := h.hashDirectory(rel)
+			if err != nil {
+				return plumbing.ZeroHash, false, err
+			}
+			if !ok {
+				continue
+			}
+
+			treeEntry.Mode = filemode.Dir
+			treeEntry.Hash = hash
+		case info.Mode().IsRegular():
+			hash, err := hashFile(full, info.Size())
+			if err != nil {
+				return plumbing.ZeroHash, false, err
+			}
+
+			treeEntry.Mode = filemode.Regular
+			if info.Mode()&0o100 != 0 {
+				treeEntry.Mode = filemode.Executable
+			}
+			treeEntry.Hash = hash
+		default:
+			continue
+		}
+
+		treeEntries = append(treeEntries, treeEntry)
+	}
+
+	hash, err := h.encodeTree(treeEntries)
+
+	return hash, len(treeEntries) > 0, err
+}
+
+// isTracked reports whether the index holds the file at rel, or any file under
+// it for directories.
+func (h worktreeHasher) isTracked(rel string, isDir bool) bool {
+	if _, ok := h.tracked[rel]; ok {
+		return true
+	}
+
+	return isDir && h.dirs[rel]
+}
+
+// nestedRepositoryHead returns the commit checked out in the repository nested
+// at rel, recorded as a submodule like `git add` does. It falls back to the
+// commit recorded in the index when the repository has no commit.
+func (h worktreeHasher) nestedRepositoryHead(rel string, full string) (plumbing.Hash, bool) {
+	if nested, err := git.PlainOpen(full); err == nil {
+		if head, err := nested.Head(); err == nil {
+			return head.Hash(), true
+		}
+	}
+
+	tracked, ok := h.tracked[rel]
+	if !ok {
+		return plumbing.ZeroHash, false
+	}
+
+	return tracked.Hash, true
+}
+
+// encodeTree returns the hash of the tree holding entries, sorted as git
+// sorts them, with directories compared as if their name ended with a slash.
+func (h worktreeHasher) encodeTree(entries []object.TreeEntry) (plumbing.Hash, error) {
+	sortKey := func(entry object.TreeEntry) string {
+		if entry.Mode == filemode.Dir {
+			return entry.Name + "/"
+		}
+
+		return entry.Name
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return sortKey(entries[i]) < sortKey(entries[j])
+	})
+
+	encoded := &plumbing.MemoryObject{}
+	if err := (&object.Tree{Entries: entries}).Encode(encoded); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return encoded.Hash(), nil
+}
+
+// hashFile returns the blob hash of the file at name.
+func hashFile(name string, size int64) (plumbing.Hash, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	defer file.Close()
+
+	hasher := plumbing.NewHasher(plumbing.BlobObject, size)
+	if _, err := io.Copy(hasher, file); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return hasher.Sum(), nil
+}
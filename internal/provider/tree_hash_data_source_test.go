@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTreeHashDataSource(t *testing.T) {
+	fixture := testAccTreeHashFixture(t, false)
+	committed := testAccTreeHashFixture(t, true)
+
+	// Changes left uncommitted in the fixture, along with an ignored file.
+	for name, content := range map[string]string{
+		"services/api/handler.go": "handler",
+		"services/api/debug.log":  "debug",
+	} {
+		if err := os.WriteFile(filepath.Join(fixture, filepath.FromSlash(name)), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_tree_hash" "api" {
+  path             = "services/api"
+  include_worktree = true
+}
+
+data "gitlocal_tree_hash" "web" {
+  path             = "services/web"
+  include_worktree = true
+}
+
+data "gitlocal_tree_hash" "root" { }
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_tree_hash.api", "hash", testAccTreeHash(t, fixture, "services/api")),
+					resource.TestCheckResourceAttr("data.gitlocal_tree_hash.api", "worktree_hash", testAccTreeHash(t, committed, "services/api")),
+
+					resource.TestCheckResourceAttr("data.gitlocal_tree_hash.web", "hash", testAccTreeHash(t, fixture, "services/web")),
+					resource.TestCheckResourceAttrPair("data.gitlocal_tree_hash.web", "worktree_hash", "data.gitlocal_tree_hash.web", "hash"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_tree_hash.root", "hash", testAccTreeHash(t, fixture, "")),
+					resource.TestCheckNoResourceAttr("data.gitlocal_tree_hash.root", "worktree_hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestTreeHashDataSourceSubmodule(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "readme")
+	lib := testAccRepositoryFixture(t, "v1")
+
+	// The submodule is left uninitialized, as an empty directory.
+	testAccSubmoduleFixture(t, fixture, "libs/lib", lib, false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_tree_hash" "libs" {
+  path             = "libs"
+  include_worktree = true
+}
+`, fixture),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_tree_hash.libs", "hash", testAccTreeHash(t, fixture, "libs")),
+					resource.TestCheckResourceAttrPair("data.gitlocal_tree_hash.libs", "worktree_hash", "data.gitlocal_tree_hash.libs", "hash"),
+				),
+			},
+		},
+	})
+}
+
+// testAccTreeHashFixture creates a repository with two services, and with the
+// handler of the api service committed when withHandler is true.
+func testAccTreeHashFixture(t *testing.T, withHandler bool) string {
+	t.Helper()
+
+	fixture := testAccRepositoryFixture(t, "readme")
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCommitFile(t, worktree, ".gitignore", "*.log\n")
+	testAccCommitFile(t, worktree, "services/api/main.go", "api")
+	testAccCommitFile(t, worktree, "services/web/main.go", "web")
+
+	if withHandler {
+		testAccCommitFile(t, worktree, "services/api/handler.go", "handler")
+	}
+
+	return fixture
+}
+
+// testAccTreeHash returns the hash of the tree at dir in HEAD of the repository
+// at repoPath.
+func testAccTreeHash(t *testing.T, repoPath string, dir string) string {
+	t.Helper()
+
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dir != "" {
+		if tree, err = tree.Tree(dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return tree.Hash.String()
+}
@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &worktreesDataSource{}
+	_ datasource.DataSourceWithConfigure = &worktreesDataSource{}
+)
+
+// NewWorktreesDataSource is a helper function to simplify the provider implementation.
+func NewWorktreesDataSource() datasource.DataSource {
+	return &worktreesDataSource{}
+}
+
+// worktreesDataSource is the data source implementation.
+type worktreesDataSource struct {
+	data *gitlocalProviderData
+}
+
+// worktreesDataSourceModel maps the data source schema data.
+type worktreesDataSourceModel struct {
+	RepositoryPath types.String     `tfsdk:"repository_path"`
+	Worktrees      []worktreesModel `tfsdk:"worktrees"`
+}
+
+// worktreesModel maps worktree schema data.
+type worktreesModel struct {
+	Branch types.String `tfsdk:"branch"`
+	Head   types.String `tfsdk:"head"`
+	Main   types.Bool   `tfsdk:"main"`
+	Path   types.String `tfsdk:"path"`
+}
+
+// Metadata returns the data source type name.
+func (d *worktreesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worktrees"
+}
+
+// Schema defines the schema for the data source.
+func (d *worktreesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the main worktree and the linked worktrees created with `git worktree add`. " +
+			"The main worktree is omitted when the repository is bare.",
+		Attributes: map[string]schema.Attribute{
+			"repository_path": RepositoryPathAttribute(),
+			"worktrees": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of worktrees of the repository, starting with the main worktree",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"branch": schema.StringAttribute{
+							Computed:    true,
+							Description: "Short name of the branch checked out in the worktree. Null when HEAD is detached",
+						},
+						"head": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash of the commit checked out in the worktree. Null when the branch has no commits yet",
+						},
+						"main": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether this is the main worktree of the repository",
+						},
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Path to the root of the worktree",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *worktreesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state worktreesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	repo, diags := d.data.Repository(state.RepositoryPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gitDir, err := repositoryGitDir(repo)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Directory",
+			err.Error(),
+		)
+		return
+	}
+
+	commonDir, err := readCommonDir(gitDir)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Common Directory",
+			err.Error(),
+		)
+		return
+	}
+
+	config, err := repo.Config()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Config",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Worktrees = nil
+
+	if !config.Core.IsBare {
+		// The main worktree is the parent of the git directory, unless
+		// core.worktree sets it, relative to the git directory.
+		mainPath := filepath.Dir(commonDir)
+		if config.Core.Worktree != "" {
+			mainPath = config.Core.Worktree
+			if !filepath.IsAbs(mainPath) {
+				mainPath = filepath.Join(commonDir, mainPath)
+			}
+		}
+
+		worktree, err := readWorktree(repo, commonDir, filepath.Clean(mainPath))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Main Git Worktree",
+				err.Error(),
+			)
+			return
+		}
+
+		worktree.Main = types.BoolValue(true)
+		state.Worktrees = append(state.Worktrees, worktree)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(commonDir, "worktrees"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		resp.Diagnostics.AddError(
+			"Unable to Read Git Worktrees",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		worktreeGitDir := filepath.Join(commonDir, "worktrees", entry.Name())
+
+		// The gitdir file points at the .git file in the root of the worktree,
+		// relative to the worktree git directory when not absolute.
+		content, err := os.ReadFile(filepath.Join(worktreeGitDir, "gitdir"))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Git Worktree `"+entry.Name()+"`",
+				err.Error(),
+			)
+			return
+		}
+
+		dotGit := strings.TrimSpace(string(content))
+		if !filepath.IsAbs(dotGit) {
+			dotGit = filepath.Join(worktreeGitDir, dotGit)
+		}
+
+		worktree, err := readWorktree(repo, worktreeGitDir, filepath.Dir(filepath.Clean(dotGit)))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Git Worktree `"+entry.Name()+"`",
+				err.Error(),
+			)
+			return
+		}
+
+		worktree.Main = types.BoolValue(false)
+		state.Worktrees = append(state.Worktrees, worktree)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *worktreesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*gitlocalProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gitlocalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.data = data
+}
+
+// readWorktree maps the HEAD of a worktree git directory. References are
+// resolved against repo, as every worktree shares the same references.
+func readWorktree(repo *git.Repository, gitDir string, worktreePath string) (worktreesModel, error) {
+	worktree := worktreesModel{
+		Branch: types.StringNull(),
+		Head:   types.StringNull(),
+		Path:   types.StringValue(worktreePath),
+	}
+
+	content, err := os.ReadFile(filepath.Join(gitDir, "HEAD"))
+	if err != nil {
+		return worktree, err
+	}
+
+	head := strings.TrimSpace(string(content))
+
+	target, isSymbolic := strings.CutPrefix(head, "ref: ")
+	if !isSymbolic {
+		worktree.Head = types.StringValue(head)
+		return worktree, nil
+	}
+
+	name := plumbing.ReferenceName(target)
+	if name.IsBranch() {
+		worktree.Branch = types.StringValue(name.Short())
+	}
+
+	ref, err := repo.Reference(name, true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return worktree, nil
+	}
+	if err != nil {
+		return worktree, err
+	}
+
+	worktree.Head = types.StringValue(ref.Hash().String())
+
+	return worktree, nil
+}
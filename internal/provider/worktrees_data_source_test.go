@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestWorktreesDataSource(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	linked := testAccLinkedWorktreeFixture(t, fixture, "feature")
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bare := filepath.Join(t.TempDir(), "bare.git")
+	if _, err := git.PlainClone(bare, true, &git.CloneOptions{URL: fixture}); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Open the provider from the linked worktree, which shares its
+				// references with the main repository.
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_head" "test" { }
+
+data "gitlocal_worktrees" "test" { }
+
+data "gitlocal_worktrees" "bare" {
+  repository_path = %q
+}
+`, linked, bare),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_head.test", "hash", head.Hash().String()),
+
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.test", "worktrees.#", "2"),
+
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.test", "worktrees.0.path", fixture),
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.test", "worktrees.0.main", "true"),
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.test", "worktrees.0.branch", "master"),
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.test", "worktrees.0.head", head.Hash().String()),
+
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.test", "worktrees.1.path", linked),
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.test", "worktrees.1.main", "false"),
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.test", "worktrees.1.branch", "feature"),
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.test", "worktrees.1.head", head.Hash().String()),
+
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.bare", "worktrees.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestWorktreesDataSourceRelativePaths(t *testing.T) {
+	fixture := testAccRepositoryFixture(t, "first")
+	linked := testAccLinkedWorktreeFixture(t, fixture, "feature")
+	gitDir := filepath.Join(fixture, ".git")
+
+	// Point at the linked worktree relatively, as worktree.useRelativePaths
+	// does, and move the main worktree with core.worktree.
+	dotGit, err := filepath.Rel(filepath.Join(gitDir, "worktrees", "feature"), filepath.Join(linked, ".git"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(gitDir, "worktrees", "feature", "gitdir"), []byte(dotGit+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	repo, err := git.PlainOpen(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.Core.Worktree = "../checkout"
+	if err := repo.SetConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "gitlocal" {
+  path = %q
+}
+
+data "gitlocal_worktrees" "test" { }
+`, linked),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.test", "worktrees.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.test", "worktrees.0.path", filepath.Join(fixture, "checkout")),
+					resource.TestCheckResourceAttr("data.gitlocal_worktrees.test", "worktrees.1.path", linked),
+				),
+			},
+		},
+	})
+}